@@ -0,0 +1,89 @@
+package tplot
+
+import (
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// HLine is a horizontal reference line at a value, for example an SLO
+// threshold or a support price. The value is included in the range of the
+// chart, and it is highlighted on the axis of the chart in the style of the
+// line.
+type HLine struct {
+	// Value is the value of the line.
+	Value Decimal
+	// Label is drawn at the left end of the line.
+	Label string
+	// Style of the line, the label and the value on the axis.
+	Style tcell.Style
+}
+
+// VMarker is a vertical marker of an item, for example a deploy.
+type VMarker struct {
+	// Index of the marked item.
+	Index int
+	// Time of the marked item. When set, it is used instead of Index by the
+	// charts with timestamps, like OHLCChart, to mark the item with the
+	// timestamp.
+	Time time.Time
+	// Label is drawn to the right of the top of the marker.
+	Label string
+	// Style of the marker and the label.
+	Style tcell.Style
+}
+
+// drawHLine draws the line at the row yy of r. The label and the line are
+// only drawn over empty cells so that the data remains visible.
+func drawHLine(screen tcell.Screen, r rect, yy int, line HLine) {
+	for i, ch := range []rune(line.Label) {
+		if i >= r.w {
+			break
+		}
+
+		setContentIfEmpty(screen, r.x+i, yy, ch, line.Style)
+	}
+
+	for xx := r.x; xx < r.x+r.w; xx++ {
+		setContentIfEmpty(screen, xx, yy, '╌', line.Style)
+	}
+}
+
+// drawVMarker draws the marker at the column xx of r. The label and the
+// marker are only drawn over empty cells so that the data remains visible.
+func drawVMarker(screen tcell.Screen, r rect, xx int, marker VMarker) {
+	for i, ch := range []rune(marker.Label) {
+		if xx+1+i >= r.x+r.w {
+			break
+		}
+
+		setContentIfEmpty(screen, xx+1+i, r.y, ch, marker.Style)
+	}
+
+	for yy := r.y; yy < r.y+r.h; yy++ {
+		setContentIfEmpty(screen, xx, yy, '╎', marker.Style)
+	}
+}
+
+// hlineValues returns the values of the lines.
+func hlineValues(lines []HLine) []Decimal {
+	ret := make([]Decimal, 0, len(lines))
+
+	for _, line := range lines {
+		if line.Value != nil {
+			ret = append(ret, line.Value)
+		}
+	}
+
+	return ret
+}
+
+// setContentIfEmpty sets the content of the cell at x, y only when it does
+// not contain anything yet.
+func setContentIfEmpty(screen tcell.Screen, x, y int, ch rune, style tcell.Style) {
+	if mainc, _, _, _ := screen.GetContent(x, y); mainc != ' ' && mainc != 0 {
+		return
+	}
+
+	screen.SetContent(x, y, ch, nil, style)
+}
@@ -0,0 +1,118 @@
+package tplot_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/jeremija/tplot"
+	"github.com/jeremija/tplot/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnnotations(t *testing.T) {
+	var factory tplot.FloatFactory
+
+	p := tplot.NewBars(factory)
+	p.SetRunes([]rune{'█'})
+	p.SetData([]tplot.Decimal{
+		tplot.Float(1),
+		tplot.Float(2),
+		tplot.Float(3),
+		tplot.Float(4),
+	})
+
+	lineStyle := tcell.StyleDefault.Foreground(tcell.ColorRed)
+	markerStyle := tcell.StyleDefault.Foreground(tcell.ColorBlue)
+
+	p.SetHLines([]tplot.HLine{{Value: tplot.Float(6), Label: "slo", Style: lineStyle}})
+	p.SetVMarkers([]tplot.VMarker{{Index: 1, Label: "d", Style: markerStyle}})
+
+	axis := tplot.NewAxis(factory)
+	box := tplot.NewAxisBox(axis, p)
+	box.SetRect(0, 0, 10, 7)
+
+	scr := test.NewScreen()
+	box.Draw(scr)
+
+	// The marker label is drawn over the line, and the value of the line
+	// replaces the tick at the top of the axis.
+	exp := `
+ 6.00sl╎d╌
+       ╎
+    4  ╎
+       ╎ █
+    2  ╎██
+       ███
+    0 ████`
+
+	fmt.Println("== expected ==")
+	fmt.Println(exp)
+	fmt.Println("==  actual  ==")
+	fmt.Println(scr.Content())
+	fmt.Println("==============")
+
+	assert.Equal(t, exp, "\n"+scr.Content())
+
+	assert.Equal(t, lineStyle, scr.Style(1, 0), "axis label")
+	assert.Equal(t, lineStyle, scr.Style(9, 0), "line")
+	assert.Equal(t, markerStyle, scr.Style(7, 3), "marker")
+}
+
+func TestOHLCChart_annotations(t *testing.T) {
+	var factory tplot.FloatFactory
+
+	p := tplot.NewOHLCChart(factory)
+	scr := test.NewScreen()
+
+	d := func(val int64) tplot.Decimal {
+		return factory.NewFromInt64(val)
+	}
+
+	ts := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	day := func(i int) time.Time {
+		return ts.AddDate(0, 0, i)
+	}
+
+	p.SetItems([]tplot.OHLC{
+		{day(0), d(10), d(12), d(9), d(11), d(100)},
+		{day(1), d(11), d(13), d(10), d(12), d(100)},
+		{day(2), d(12), d(14), d(11), d(13), d(100)},
+		{day(3), d(13), d(15), d(12), d(14), d(100)},
+	})
+
+	p.SetHLines([]tplot.HLine{{Value: d(20), Label: "r"}})
+	p.SetVMarkers([]tplot.VMarker{
+		{Time: day(2).Add(time.Hour), Label: "v"},
+		{Time: day(-1), Label: "x"},
+	})
+	p.SetTimeAxisVisible(false)
+	p.SetRect(0, 0, 12, 12)
+	p.Draw(scr)
+
+	// The marker is resolved to the item of the day, the marker before the
+	// first item is not drawn, and the volume bars hide the marker.
+	exp := `
+r╌╌╌╎v 20.00
+    ╎
+    ╎
+    ╎
+    ╎
+    ╷╻ 14.00
+   ╷╽╿
+  ╷╽╿╵
+  ╽╿╵
+  ╹╵╎     10
+  ████   100
+  ████`
+
+	fmt.Println("== expected ==")
+	fmt.Println(exp)
+	fmt.Println("==  actual  ==")
+	fmt.Println(scr.Content())
+	fmt.Println("==============")
+
+	assert.Equal(t, exp, "\n"+scr.Content())
+}
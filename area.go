@@ -0,0 +1,191 @@
+package tplot
+
+import (
+	"github.com/gdamore/tcell/v2"
+)
+
+// AreaFill describes where the region under the values of an Area is filled
+// down to.
+type AreaFill int
+
+const (
+	// AreaFillZero fills the region between the values and zero. Negative
+	// values of a single series are filled downwards from zero.
+	AreaFillZero AreaFill = iota
+	// AreaFillMin fills the region between the values and the minimum of
+	// the scale.
+	AreaFillMin
+)
+
+// DefaultAreaRunes contains the block characters used to draw the eighths of
+// a cell.
+var DefaultAreaRunes = []rune{'▁', '▂', '▃', '▄', '▅', '▆', '▇', '█'}
+
+// Area is an area chart that fills the region under the values. Each item
+// fills spacing columns. By default the data is drawn in the style of the
+// Area. When series are set, they are stacked on top of each other in their
+// own styles instead, and negative values are drawn as zeros. The filled
+// region is dimmed, so that the top cell of each column draws a line along
+// the values. Only RenderRunes is supported.
+type Area struct {
+	*base
+
+	series    []Series
+	fill      AreaFill
+	lineStyle tcell.Style
+}
+
+// NewArea creates a new instance of Area.
+func NewArea(factory DecimalFactory) *Area {
+	return &Area{
+		base: newBase(factory, DefaultAreaRunes),
+	}
+}
+
+// SetSeries sets the stacked series, which are drawn instead of the data.
+func (b *Area) SetSeries(series []Series) {
+	b.series = series
+}
+
+// Series returns the stacked series.
+func (b *Area) Series() []Series {
+	return b.series
+}
+
+// SetFill sets where the region under the values is filled down to.
+func (b *Area) SetFill(fill AreaFill) {
+	b.fill = fill
+}
+
+// Fill returns where the region under the values is filled down to.
+func (b *Area) Fill() AreaFill {
+	return b.fill
+}
+
+// SetLineStyle sets the style of the top cell of each column, which draws a
+// line on top of the area. When the style is tcell.StyleDefault, the top
+// cells are drawn in the styles of the area without dimming them.
+func (b *Area) SetLineStyle(style tcell.Style) {
+	b.lineStyle = style
+}
+
+// LineStyle returns the style of the top cell of each column.
+func (b *Area) LineStyle() tcell.Style {
+	return b.lineStyle
+}
+
+// Draw implements tview.Primitive.
+func (b *Area) Draw(screen tcell.Screen) {
+	b.DrawForSubclass(screen, b)
+
+	x, y, w, h := b.GetInnerRect()
+	spacing := b.spacing
+	runes := b.runes
+
+	if h == 0 || w == 0 {
+		return
+	}
+
+	if len(runes) == 0 {
+		runes = []rune{'█'}
+	}
+
+	numFractions := len(runes)
+
+	values, styles, start := b.layers(b.series, w/spacing)
+
+	fillStyles := make([]tcell.Style, len(styles))
+
+	for j, style := range styles {
+		fillStyles[j] = style.Dim(true)
+	}
+
+	// The values of the layers are summed so that each layer is drawn on
+	// top of the previous one.
+	for j := 1; j < len(values); j++ {
+		for i, value := range values[j] {
+			values[j][i] = values[j-1][i].Add(value)
+		}
+	}
+
+	rng := NewRange(b.factory)
+
+	for _, layer := range values {
+		for _, value := range layer {
+			rng = rng.Feed(value)
+		}
+	}
+
+	for _, value := range hlineValues(b.hlines) {
+		rng = rng.Feed(value)
+	}
+
+	if b.fill == AreaFillZero {
+		rng = rng.Feed(b.factory.Zero())
+	}
+
+	b.scale.SetRange(rng)
+
+	// If we're sharing the scale with other components that can't use the
+	// fractions. One more place so that the maximum fills the whole height.
+	scale := b.scale.Copy()
+	scale.SetSize(h*numFractions + 1)
+
+	baseline := 0
+
+	if b.fill == AreaFillZero {
+		// The baseline is rounded to the nearest row boundary so that the
+		// area on both sides starts at a whole cell.
+		baseline = (scale.Value(b.factory.Zero()) + numFractions/2) / numFractions
+	}
+
+	bottom := y + h - baseline - 1
+	l := len(values[0])
+
+	for i := 0; i < l; i++ {
+		xx := x + i*spacing + (w - l*spacing)
+
+		tops := make([]int, len(values))
+
+		for j := range values {
+			tops[j] = scale.Value(values[j][i]) - baseline*numFractions
+		}
+
+		top := tops[len(tops)-1]
+
+		for k := 0; k < spacing; k++ {
+			if top < 0 {
+				drawBarDown(screen, xx+k, bottom+1, -top, runes, fillStyles[0])
+
+				// The line of negative values is at the bottom.
+				row := (-top - 1) / numFractions
+				ch, _, style, _ := screen.GetContent(xx+k, bottom+1+row)
+
+				screen.SetContent(xx+k, bottom+1+row, ch, nil, style.Dim(false))
+
+				continue
+			}
+
+			drawStackedColumn(screen, xx+k, bottom, runes, tops, fillStyles)
+
+			if top == 0 {
+				continue
+			}
+
+			row := (top - 1) / numFractions
+
+			if b.lineStyle != tcell.StyleDefault {
+				ch := runes[top-row*numFractions-1]
+
+				screen.SetContent(xx+k, bottom-row, ch, nil, b.lineStyle)
+				continue
+			}
+
+			ch, _, style, _ := screen.GetContent(xx+k, bottom-row)
+
+			screen.SetContent(xx+k, bottom-row, ch, nil, style.Dim(false))
+		}
+	}
+
+	b.drawAnnotations(screen, start, l)
+}
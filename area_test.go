@@ -0,0 +1,158 @@
+package tplot_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/jeremija/tplot"
+	"github.com/jeremija/tplot/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArea(t *testing.T) {
+	var factory tplot.FloatFactory
+
+	d := func(values ...float64) []tplot.Decimal {
+		ret := make([]tplot.Decimal, len(values))
+
+		for i, v := range values {
+			ret[i] = tplot.Float(v)
+		}
+
+		return ret
+	}
+
+	p := tplot.NewArea(factory)
+	p.SetData(d(1, 2, 3, 4))
+	p.SetRect(0, 0, 4, 2)
+
+	scr := test.NewScreen()
+	p.Draw(scr)
+
+	exp := `
+  ▄█
+▄███`
+
+	fmt.Println("== expected ==")
+	fmt.Println(exp)
+	fmt.Println("==  actual  ==")
+	fmt.Println(scr.Content())
+	fmt.Println("==============")
+
+	assert.Equal(t, exp, "\n"+scr.Content())
+
+	p.SetFill(tplot.AreaFillMin)
+
+	scr = test.NewScreen()
+	p.Draw(scr)
+
+	exp = `
+  ▂█
+ ▅██`
+
+	fmt.Println("== expected ==")
+	fmt.Println(exp)
+	fmt.Println("==  actual  ==")
+	fmt.Println(scr.Content())
+	fmt.Println("==============")
+
+	assert.Equal(t, exp, "\n"+scr.Content())
+
+	green := tcell.StyleDefault.Foreground(tcell.ColorGreen)
+	blue := tcell.StyleDefault.Foreground(tcell.ColorBlue)
+
+	p.SetFill(tplot.AreaFillZero)
+	p.SetSpacing(2)
+	p.SetSeries([]tplot.Series{
+		{Name: "rx", Style: green, Data: d(1, 2)},
+		{Name: "tx", Style: blue, Data: d(1, -1)},
+	})
+
+	scr = test.NewScreen()
+	p.Draw(scr)
+
+	exp = `
+████
+████`
+
+	fmt.Println("== expected ==")
+	fmt.Println(exp)
+	fmt.Println("==  actual  ==")
+	fmt.Println(scr.Content())
+	fmt.Println("==============")
+
+	assert.Equal(t, exp, "\n"+scr.Content())
+
+	fg := func(x, y int) tcell.Color {
+		fg, _, _ := scr.Style(x, y).Decompose()
+		return fg
+	}
+
+	assert.Equal(t, tcell.ColorBlue, fg(0, 0))
+	assert.Equal(t, tcell.ColorGreen, fg(1, 1))
+	assert.Equal(t, tcell.ColorGreen, fg(3, 0))
+
+	// A single series is filled downwards from zero.
+	p.SetSeries(nil)
+	p.SetSpacing(1)
+	p.SetData(d(-2, 2))
+	p.SetLineStyle(blue)
+
+	scr = test.NewScreen()
+	p.Draw(scr)
+
+	exp = `
+   █
+  █`
+
+	assert.Equal(t, exp, "\n"+scr.Content())
+	assert.Equal(t, tcell.ColorBlue, fg(3, 0))
+}
+
+func TestArea_line(t *testing.T) {
+	p := tplot.NewArea(tplot.FloatFactory{})
+	p.SetData([]tplot.Decimal{tplot.Float(1), tplot.Float(3), tplot.Float(4)})
+	p.SetRect(0, 0, 3, 2)
+
+	scr := test.NewScreen()
+	p.Draw(scr)
+
+	exp := `
+ ▄█
+▄██`
+
+	assert.Equal(t, exp, "\n"+scr.Content())
+
+	dim := func(x, y int) bool {
+		_, _, attrs := scr.Style(x, y).Decompose()
+		return attrs&tcell.AttrDim != 0
+	}
+
+	// The top cells draw the line, the region under them is dimmed.
+	assert.False(t, dim(0, 1))
+	assert.False(t, dim(1, 0))
+	assert.True(t, dim(1, 1))
+	assert.False(t, dim(2, 0))
+	assert.True(t, dim(2, 1))
+
+	// The line of negative values is at the bottom.
+	p.SetData([]tplot.Decimal{tplot.Float(-4), tplot.Float(4)})
+	p.SetRect(0, 0, 2, 4)
+
+	scr = test.NewScreen()
+	p.Draw(scr)
+
+	exp = `
+ █
+ █
+█
+█`
+
+	assert.Equal(t, exp, "\n"+scr.Content())
+
+	assert.True(t, dim(0, 2))
+	assert.False(t, dim(0, 3))
+	assert.False(t, dim(1, 0))
+	assert.True(t, dim(1, 1))
+}
@@ -1,21 +1,25 @@
 package tplot
 
 import (
-	"strconv"
+	"unicode/utf8"
 
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
 )
 
-// Axis represents a chart Axis. The current implementation only supports a
-// vertical axis.
+// Axis represents a chart Axis. The axis is vertical by default, with the
+// values increasing upwards. A horizontal axis has the values increasing to
+// the right, see also TimeAxis for a horizontal axis with timestamps.
 type Axis struct {
 	*tview.Box
 	factory        DecimalFactory
+	direction      Direction
 	scale          Scale
 	style          tcell.Style
 	highlightStyle tcell.Style
 	highlight      DecimalValue
+	formatter      Formatter
+	hlines         []HLine
 }
 
 // NewAxis creates a new instance of Axis.
@@ -23,12 +27,25 @@ func NewAxis(factory DecimalFactory) *Axis {
 	return &Axis{
 		factory:        factory,
 		Box:            tview.NewBox(),
+		direction:      DirectionVertical,
 		style:          tcell.StyleDefault,
 		scale:          NewScaleLinear(factory),
 		highlightStyle: tcell.StyleDefault,
+		formatter:      DefaultFormatter,
 	}
 }
 
+// SetDirection sets the axis direction. A vertical axis labels the rows and
+// a horizontal axis labels the columns.
+func (a *Axis) SetDirection(direction Direction) {
+	a.direction = direction
+}
+
+// Direction returns the axis direction.
+func (a *Axis) Direction() Direction {
+	return a.direction
+}
+
 // SetStyle sets a default axis style.
 func (a *Axis) SetStyle(style tcell.Style) {
 	a.style = style
@@ -59,6 +76,37 @@ func (a *Axis) Highlight() DecimalValue {
 	return a.highlight
 }
 
+// SetHLines sets the horizontal lines whose values are labeled on a vertical
+// axis in the style of the line.
+func (a *Axis) SetHLines(lines []HLine) {
+	a.hlines = lines
+}
+
+// HLines returns the horizontal lines labeled on the axis.
+func (a *Axis) HLines() []HLine {
+	return a.hlines
+}
+
+// SetFormatter sets the formatter of the labels. DefaultFormatter is used
+// when formatter is nil.
+func (a *Axis) SetFormatter(formatter Formatter) {
+	if formatter == nil {
+		formatter = DefaultFormatter
+	}
+
+	a.formatter = formatter
+}
+
+// Formatter returns the formatter of the labels.
+func (a *Axis) Formatter() Formatter {
+	return a.formatter
+}
+
+// Format formats value like the highlighted value on the axis.
+func (a *Axis) Format(value Decimal) string {
+	return a.formatter(value, a.valueDecimals(a.NumDecimals()))
+}
+
 // SetScale sets the axis acale.
 func (a *Axis) SetScale(scale Scale) {
 	a.scale = scale
@@ -69,76 +117,326 @@ func (a *Axis) Scale() Scale {
 	return a.scale
 }
 
-// CalcWidth calculates the width of the axis.
+// NumDecimals returns the number of decimals used to format the tick labels
+// on the axis, which is the number needed to tell the ticks apart. When there
+// are fewer than two ticks, it depends on the resolution of the scale.
+func (a *Axis) NumDecimals() int {
+	_, numDecs := a.ticksDecimals()
+
+	return numDecs
+}
+
+// valueDecimals returns the number of decimals used to format the highlight
+// and the horizontal lines, which are rarely round like the ticks, given the
+// number of decimals of the ticks.
+func (a *Axis) valueDecimals(numDecs int) int {
+	if n := a.scale.NumDecimals() + 2; n > numDecs {
+		return n
+	}
+
+	return numDecs
+}
+
+// Ticks returns the values labeled on the axis, from the lowest. The values
+// are chosen by the scale when it implements ScaleTicker, and there is at
+// least one empty row between two labels. On a horizontal axis there is at
+// least one empty column between two labels. The ticks can also be used to
+// draw gridlines.
+func (a *Axis) Ticks() []AxisTick {
+	ticks, _ := a.ticksDecimals()
+
+	return ticks
+}
+
+// ticksDecimals returns the ticks and the number of decimals of their
+// labels.
+func (a *Axis) ticksDecimals() ([]AxisTick, int) {
+	if a.direction != DirectionHorizontal {
+		return a.ticks(axisTickGap)
+	}
+
+	// The labels on a horizontal axis are wider than one column, so the gap
+	// is increased until it fits the widest label.
+	gap := axisTickGap
+
+	for i := 0; ; i++ {
+		ticks, numDecs := a.ticks(gap)
+
+		need := 0
+
+		for _, tick := range ticks {
+			if l := utf8.RuneCountInString(tick.Label) + 1; l > need {
+				need = l
+			}
+		}
+
+		if need <= gap || i == 10 {
+			return ticks, numDecs
+		}
+
+		gap = need
+	}
+}
+
+// ticks returns the ticks with at least minGap places on the scale between
+// them, and the number of decimals of their labels.
+func (a *Axis) ticks(minGap int) ([]AxisTick, int) {
+	scale := a.scale
+	size := scale.Size()
+
+	var values []Decimal
+
+	if ticker, ok := scale.(ScaleTicker); ok {
+		values = ticker.Ticks(minGap)
+	} else {
+		values = niceTicks(a.factory, scale.Range(), size, minGap)
+	}
+
+	numDecs := scale.NumDecimals() + 2
+	if len(values) > 1 {
+		numDecs = tickDecimals(values)
+	}
+
+	ret := make([]AxisTick, 0, len(values))
+
+	for _, value := range values {
+		row := scale.Value(value)
+
+		if row < 0 || row >= size {
+			continue
+		}
+
+		if l := len(ret); l > 0 && row-ret[l-1].Row < minGap {
+			continue
+		}
+
+		ret = append(ret, AxisTick{
+			Value: value,
+			Row:   row,
+			Label: a.formatter(value, numDecs),
+		})
+	}
+
+	return ret, numDecs
+}
+
+// CalcWidth calculates the width of a vertical axis from the widths of the
+// labels, with a space between the content and the labels. For a horizontal
+// axis, whose labels are drawn on a single row, it is the width of the widest
+// label plus one.
 func (a *Axis) CalcWidth() int {
-	// numDecs contains the number of decimals spot to represent the axis.
-	numDecs := a.scale.NumDecimals() + 2
+	ticks, numDecs := a.ticksDecimals()
+	numDecs = a.valueDecimals(numDecs)
 
-	rng := a.scale.Range()
+	size := 0
 
-	size := len(rng.Max.Round().String()) + 1 + numDecs
+	for _, tick := range ticks {
+		if l := utf8.RuneCountInString(tick.Label); l > size {
+			size = l
+		}
+	}
 
-	return size
+	// Reserve the room for any highlighted value within the range, so that
+	// the width does not change when the highlight moves.
+	if a.highlight.Valid {
+		rng := a.scale.Range()
+
+		for _, label := range []string{
+			a.formatter(rng.Min, numDecs),
+			a.formatter(rng.Max, numDecs),
+			a.formatter(a.highlight.Decimal, numDecs),
+		} {
+			if l := utf8.RuneCountInString(label); l > size {
+				size = l
+			}
+		}
+	}
+
+	if a.direction != DirectionHorizontal {
+		for _, value := range hlineValues(a.hlines) {
+			if l := utf8.RuneCountInString(a.formatter(value, numDecs)); l > size {
+				size = l
+			}
+		}
+	}
+
+	// Leave a space between the content and the labels.
+	return size + 1
 }
 
 // Draw implements tview.Primitive.
 func (a *Axis) Draw(screen tcell.Screen) {
 	a.Box.DrawForSubclass(screen, a)
 
+	if a.direction == DirectionHorizontal {
+		a.drawHorizontal(screen)
+		return
+	}
+
 	x, y, w, h := a.GetInnerRect()
 
-	highlight := a.highlight
-	scale := a.scale
+	ticks, numDecs := a.ticksDecimals()
+	numDecs = a.valueDecimals(numDecs)
 
-	var highlightInt int
+	highlightRow := -1
 
 	if a.highlight.Valid {
-		highlightInt = scale.Value(highlight.Decimal)
+		highlightRow = a.scale.Value(a.highlight.Decimal)
 	}
 
-	// numDecs contains the number of decimals spot to represent the axis.
-	numDecs := scale.NumDecimals() + 2
+	labels := make(map[int]string)
+	styles := make(map[int]tcell.Style)
 
-	maxWidth := 0
+	// The rows of the lines, which hide the ticks around them like the
+	// highlight.
+	marked := make([]int, 0, len(a.hlines)+1)
 
-	vals := make([]string, h)
+	if highlightRow >= 0 {
+		marked = append(marked, highlightRow)
+	}
 
-	for i := 0; i < h; i++ {
-		rev := scale.Reverse(i)
+	for _, line := range a.hlines {
+		if line.Value == nil {
+			continue
+		}
+
+		row := a.scale.Value(line.Value)
 
-		if highlight.Valid && i == highlightInt {
-			rev = highlight.Decimal
+		if row < 0 || row >= h || row == highlightRow {
+			continue
 		}
 
-		valFloat := rev.Float64()
+		labels[row] = a.formatter(line.Value, numDecs)
+		styles[row] = line.Style
+		marked = append(marked, row)
+	}
 
-		valStr := strconv.FormatFloat(valFloat, 'f', numDecs, 64)
+	for _, tick := range ticks {
+		hidden := false
 
-		if l := len(valStr); l > maxWidth {
-			maxWidth = l
+		// Keep the gap between the labels around the highlight and the
+		// lines too.
+		for _, row := range marked {
+			if abs(tick.Row-row) < axisTickGap {
+				hidden = true
+				break
+			}
 		}
 
-		vals[i] = valStr
+		if !hidden {
+			labels[tick.Row] = tick.Label
+		}
+	}
+
+	if highlightRow >= 0 {
+		labels[highlightRow] = a.formatter(a.highlight.Decimal, numDecs)
+		styles[highlightRow] = a.highlightStyle
 	}
 
 	// Hide axis when no room.
-	if w < maxWidth {
+	for _, label := range labels {
+		if utf8.RuneCountInString(label) > w {
+			return
+		}
+	}
+
+	for row, label := range labels {
+		if row >= h {
+			continue
+		}
+
+		yy := y + h - row - 1
+
+		currentStyle, ok := styles[row]
+		if !ok {
+			currentStyle = a.style
+		}
+
+		runes := []rune(label)
+
+		for i, ch := range runes {
+			xx := x + w - len(runes) + i
+
+			screen.SetContent(xx, yy, ch, nil, currentStyle)
+		}
+	}
+}
+
+// drawHorizontal draws the labels centered around their columns on the first
+// row. Labels that would overlap the highlight or the previous label are
+// skipped.
+func (a *Axis) drawHorizontal(screen tcell.Screen) {
+	x, y, w, h := a.GetInnerRect()
+
+	if h == 0 || w == 0 {
 		return
 	}
 
-	for i, valStr := range vals {
-		yy := y + h - i - 1
+	type span struct {
+		start int
+		label []rune
+		style tcell.Style
+	}
+
+	place := func(col int, label string, style tcell.Style) span {
+		runes := []rune(label)
+		start := col - (len(runes)-1)/2
 
-		currentStyle := a.style
+		if start > w-len(runes) {
+			start = w - len(runes)
+		}
 
-		if highlight.Valid && i == highlightInt {
-			currentStyle = a.highlightStyle
+		if start < 0 {
+			start = 0
 		}
 
-		for i, val := range valStr {
-			xx := x + w - len(valStr) + i
+		return span{start: start, label: runes, style: style}
+	}
+
+	// overlaps returns true when there is no empty column between p and q.
+	overlaps := func(p, q span) bool {
+		return p.start <= q.start+len(q.label) && q.start <= p.start+len(p.label)
+	}
+
+	ticks, numDecs := a.ticksDecimals()
+	numDecs = a.valueDecimals(numDecs)
+
+	var spans []span
+
+	var highlight *span
+
+	if a.highlight.Valid {
+		if col := a.scale.Value(a.highlight.Decimal); col >= 0 && col < w {
+			s := place(col, a.formatter(a.highlight.Decimal, numDecs), a.highlightStyle)
+			highlight = &s
+		}
+	}
+
+	for _, tick := range ticks {
+		s := place(tick.Row, tick.Label, a.style)
+
+		if l := len(spans); l > 0 && overlaps(spans[l-1], s) {
+			continue
+		}
+
+		if highlight != nil && overlaps(*highlight, s) {
+			continue
+		}
+
+		spans = append(spans, s)
+	}
+
+	if highlight != nil {
+		spans = append(spans, *highlight)
+	}
+
+	for _, s := range spans {
+		if len(s.label) > w {
+			continue
+		}
 
-			screen.SetContent(xx, yy, val, nil, currentStyle)
+		for i, ch := range s.label {
+			screen.SetContent(x+s.start+i, y, ch, nil, s.style)
 		}
 	}
 }
@@ -12,6 +12,12 @@ type AxisBox struct {
 	content  Primitive
 	scale    Scale
 	position Position
+
+	legend         *Legend
+	legendPosition Position
+
+	// contentRect is the rect of the content during the last Draw.
+	contentRect rect
 }
 
 func NewAxisBox(axis *Axis, content Primitive) *AxisBox {
@@ -31,18 +37,176 @@ func (a *AxisBox) Position() Position {
 	return a.position
 }
 
+// SetLegend sets the legend and its position. The legend takes the room
+// next to the axis and the content at the Top, Bottom, Left and Right
+// positions, and floats over a corner of the content at the TopLeft,
+// TopRight, BottomLeft and BottomRight positions. A nil legend is not drawn.
+func (a *AxisBox) SetLegend(legend *Legend, position Position) {
+	a.legend = legend
+	a.legendPosition = position
+}
+
+// Legend returns the legend. May be nil.
+func (a *AxisBox) Legend() *Legend {
+	return a.legend
+}
+
+// LegendPosition returns the position of the legend.
+func (a *AxisBox) LegendPosition() Position {
+	return a.legendPosition
+}
+
 func (a *AxisBox) Draw(screen tcell.Screen) {
 	a.Box.DrawForSubclass(screen, a)
 
+	x, y, w, h := a.layoutLegend()
+
+	if a.position == Top || a.position == Bottom {
+		a.drawHorizontal(screen, x, y, w, h)
+	} else {
+		a.drawVertical(screen, x, y, w, h)
+	}
+
+	a.drawLegend(screen)
+}
+
+// layoutLegend sets the rect of a legend next to the content and returns the
+// rect left for the axis and the content.
+func (a *AxisBox) layoutLegend() (int, int, int, int) {
+	x, y, w, h := a.Box.GetInnerRect()
+
+	legend := a.legend
+
+	if legend == nil || a.legendPosition.isCorner() {
+		return x, y, w, h
+	}
+
+	if a.legendPosition == Top || a.legendPosition == Bottom {
+		legend.SetDirection(DirectionHorizontal)
+	} else {
+		legend.SetDirection(DirectionVertical)
+	}
+
+	legendW, legendH := legend.CalcSize()
+
+	switch a.legendPosition {
+	case Top, Bottom:
+		if legendH >= h {
+			legendH = 0
+		}
+
+		legendY := y + h - legendH
+
+		if a.legendPosition == Top {
+			legendY = y
+			y += legendH
+		}
+
+		h -= legendH
+
+		legend.SetRect(x, legendY, w, legendH)
+	default:
+		if legendW >= w {
+			legendW = 0
+		}
+
+		legendX := x + w - legendW
+
+		if a.legendPosition == Left {
+			legendX = x
+			x += legendW
+		}
+
+		w -= legendW
+
+		legend.SetRect(legendX, y, legendW, h)
+	}
+
+	return x, y, w, h
+}
+
+// drawLegend draws the legend. A legend in a corner is drawn over the data
+// when the content implements PlotArea.
+func (a *AxisBox) drawLegend(screen tcell.Screen) {
+	legend := a.legend
+
+	if legend == nil {
+		return
+	}
+
+	if !a.legendPosition.isCorner() {
+		legend.Draw(screen)
+		return
+	}
+
+	x, y, w, h := a.contentRect.x, a.contentRect.y, a.contentRect.w, a.contentRect.h
+
+	if plot, ok := a.content.(PlotArea); ok {
+		x, y, w, h = plot.PlotRect()
+	}
+
+	legend.SetDirection(DirectionVertical)
+
+	legendW, legendH := legend.CalcSize()
+
+	if legendW > w {
+		legendW = w
+	}
+
+	if legendH > h {
+		legendH = h
+	}
+
+	if a.legendPosition == TopRight || a.legendPosition == BottomRight {
+		x += w - legendW
+	}
+
+	if a.legendPosition == BottomLeft || a.legendPosition == BottomRight {
+		y += h - legendH
+	}
+
+	legend.SetRect(x, y, legendW, legendH)
+	legend.Draw(screen)
+}
+
+// drawVertical draws a vertical axis on the left or the right of the
+// content.
+func (a *AxisBox) drawVertical(screen tcell.Screen, x, y, w, h int) {
 	scale := a.content.Scale()
 
 	a.axis.SetScale(scale)
+	a.axis.SetDirection(DirectionVertical)
 
-	x, y, w, h := a.Box.GetInnerRect()
+	if content, ok := a.content.(interface{ HLines() []HLine }); ok {
+		a.axis.SetHLines(content.HLines())
+	}
 
 	scale.SetSize(h)
 
 	axisW := a.axis.CalcWidth()
+
+	a.drawVerticalContent(screen, x, y, w, h, axisW)
+
+	// The content sets the range of the scale when it is drawn, so the
+	// labels can need a different width than before, e.g. on the first
+	// Draw. The content is drawn once more so that it is aligned with the
+	// axis.
+	if calcW := a.axis.CalcWidth(); calcW != axisW {
+		background := tcell.StyleDefault.Background(a.GetBackgroundColor())
+
+		for yy := y; yy < y+h; yy++ {
+			for xx := x; xx < x+w; xx++ {
+				screen.SetContent(xx, yy, ' ', nil, background)
+			}
+		}
+
+		a.drawVerticalContent(screen, x, y, w, h, calcW)
+	}
+}
+
+// drawVerticalContent draws the content and an axis that is axisW columns
+// wide on the left or the right of the content.
+func (a *AxisBox) drawVerticalContent(screen tcell.Screen, x, y, w, h, axisW int) {
 	barsW := w - axisW
 
 	// Hide axis when there's no space
@@ -51,16 +215,63 @@ func (a *AxisBox) Draw(screen tcell.Screen) {
 		barsW = w
 	}
 
+	contentX, axisX := x+axisW, x
+
+	if a.position == Right {
+		contentX, axisX = x, x+barsW
+	}
+
 	// We need to draw the content first because the scale.Range
 	// might change.
-	if a.position == Right {
-		a.content.SetRect(x, y, barsW, h)
-		a.axis.SetRect(x+barsW, y, axisW, h)
-	} else {
-		a.content.SetRect(x+axisW, y, barsW, h)
-		a.axis.SetRect(x, y, axisW, h)
+	a.contentRect = rect{x: contentX, y: y, w: barsW, h: h}
+	a.content.SetRect(contentX, y, barsW, h)
+	a.content.Draw(screen)
+
+	axisY, axisH := y, h
+
+	if plot, ok := a.content.(PlotArea); ok {
+		_, axisY, _, axisH = plot.PlotRect()
 	}
 
+	a.axis.SetRect(axisX, axisY, axisW, axisH)
+	a.axis.Draw(screen)
+}
+
+// drawHorizontal draws a horizontal axis above or below the content. The
+// axis is aligned with the data when the content implements PlotArea.
+func (a *AxisBox) drawHorizontal(screen tcell.Screen, x, y, w, h int) {
+	scale := a.content.Scale()
+
+	a.axis.SetScale(scale)
+	a.axis.SetDirection(DirectionHorizontal)
+
+	scale.SetSize(w)
+
+	axisH := 1
+	contentH := h - axisH
+
+	// Hide axis when there's no space
+	if contentH <= 0 {
+		axisH = 0
+		contentH = h
+	}
+
+	contentY, axisY := y, y+contentH
+
+	if a.position == Top {
+		contentY, axisY = y+axisH, y
+	}
+
+	a.contentRect = rect{x: x, y: contentY, w: w, h: contentH}
+	a.content.SetRect(x, contentY, w, contentH)
 	a.content.Draw(screen)
+
+	axisX, axisW := x, w
+
+	if plot, ok := a.content.(PlotArea); ok {
+		axisX, _, axisW, _ = plot.PlotRect()
+	}
+
+	a.axis.SetRect(axisX, axisY, axisW, axisH)
 	a.axis.Draw(screen)
 }
@@ -0,0 +1,140 @@
+package tplot_test
+
+import (
+	"testing"
+
+	"github.com/jeremija/tplot"
+	"github.com/jeremija/tplot/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAxis(t *testing.T) {
+	var factory tplot.FloatFactory
+
+	labels := func(ticks []tplot.AxisTick) []string {
+		ret := []string{}
+
+		for _, tick := range ticks {
+			ret = append(ret, tick.Label)
+		}
+
+		return ret
+	}
+
+	rows := func(ticks []tplot.AxisTick) []int {
+		ret := []int{}
+
+		for _, tick := range ticks {
+			ret = append(ret, tick.Row)
+		}
+
+		return ret
+	}
+
+	scale := tplot.NewScaleLinear(factory)
+	scale.SetRange(tplot.Range{
+		Min: tplot.Float(97.3),
+		Max: tplot.Float(103.2871),
+	})
+	scale.SetSize(10)
+
+	a := tplot.NewAxis(factory)
+	a.SetScale(scale)
+
+	ticks := a.Ticks()
+	assert.Equal(t, []string{"98", "100", "102"}, labels(ticks))
+	assert.Equal(t, []int{1, 4, 7}, rows(ticks))
+	assert.Equal(t, 4, a.CalcWidth())
+
+	scale.SetRange(tplot.Range{
+		Min: tplot.Float(0),
+		Max: tplot.Float(1),
+	})
+
+	assert.Equal(t, []string{"0.0", "0.5", "1.0"}, labels(a.Ticks()))
+
+	log := tplot.NewScaleLog(factory)
+	log.SetRange(tplot.Range{
+		Min: tplot.Float(0.5),
+		Max: tplot.Float(20000),
+	})
+	log.SetSize(10)
+	a.SetScale(log)
+
+	assert.Equal(t, []string{"1", "100", "10000"}, labels(a.Ticks()))
+
+	scale.SetRange(tplot.Range{
+		Min: tplot.Float(0),
+		Max: tplot.Float(9),
+	})
+	a.SetScale(scale)
+	a.SetHighlight(tplot.DecimalValue{
+		Decimal: tplot.Float(3),
+		Valid:   true,
+	})
+	a.SetRect(0, 0, a.CalcWidth(), 10)
+
+	scr := test.NewScreen()
+	a.Draw(scr)
+
+	exp := `
+
+    8
+
+    6
+
+
+ 3.00
+
+
+    0`
+
+	assert.Equal(t, exp, "\n"+scr.Content())
+}
+
+func TestAxis_highlightPrecision(t *testing.T) {
+	var factory tplot.FloatFactory
+
+	scale := tplot.NewScaleLinear(factory)
+	scale.SetRange(tplot.Range{
+		Min: tplot.Float(97.3),
+		Max: tplot.Float(103.2871),
+	})
+	scale.SetSize(10)
+
+	a := tplot.NewAxis(factory)
+	a.SetScale(scale)
+
+	// The highlight and the lines are not on the ticks, so they keep the
+	// precision of the scale.
+	a.SetHighlight(tplot.DecimalValue{
+		Decimal: tplot.Float(99.37),
+		Valid:   true,
+	})
+	a.SetHLines([]tplot.HLine{{
+		Value: tplot.Float(102.71),
+	}})
+
+	assert.Equal(t, 0, a.NumDecimals())
+	assert.Equal(t, "99.37", a.Format(tplot.Float(99.37)))
+	assert.Equal(t, 7, a.CalcWidth())
+
+	a.SetRect(0, 0, a.CalcWidth(), 10)
+
+	scr := test.NewScreen()
+	a.Draw(scr)
+
+	exp := `
+
+ 102.71
+
+
+
+
+  99.37
+
+     98
+`
+
+	assert.Equal(t, exp, "\n"+scr.Content())
+}
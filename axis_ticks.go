@@ -0,0 +1,103 @@
+package tplot
+
+import (
+	"fmt"
+	"math"
+)
+
+// axisTickGap is the minimum distance in rows between two ticks, so that
+// there is at least one empty row between two labels.
+const axisTickGap = 2
+
+// AxisTick is a value labeled on the Axis.
+type AxisTick struct {
+	// Value is the tick value.
+	Value Decimal
+	// Row is the row of the tick on the scale, counting from the bottom.
+	Row int
+	// Label is the formatted value.
+	Label string
+}
+
+// niceTicks returns the multiples of the smallest step of 1, 2 or 5 times a
+// power of ten within rng, so that there are at least minGap places of a
+// scale with size between two ticks.
+func niceTicks(factory DecimalFactory, rng Range, size int, minGap int) []Decimal {
+	if size <= 0 {
+		return nil
+	}
+
+	if !rng.Max.GreaterThan(rng.Min) || size == 1 {
+		return []Decimal{rng.Min}
+	}
+
+	min, max := rng.Min.Float64(), rng.Max.Float64()
+
+	minStep := (max - min) * float64(minGap) / float64(size-1)
+	if !(minStep > 0) {
+		return []Decimal{rng.Min}
+	}
+
+	for exp := int(math.Floor(math.Log10(minStep))); exp < 309; exp++ {
+		for _, mantissa := range []int64{1, 2, 5} {
+			if float64(mantissa)*math.Pow10(exp) < minStep {
+				continue
+			}
+
+			step, err := NewFromString(factory, fmt.Sprintf("%de%d", mantissa, exp))
+			if err != nil || !step.GreaterThan(factory.Zero()) {
+				// The step cannot be represented by the factory.
+				continue
+			}
+
+			return stepTicks(factory, rng, step)
+		}
+	}
+
+	return []Decimal{rng.Min}
+}
+
+// stepTicks returns the multiples of step within rng.
+func stepTicks(factory DecimalFactory, rng Range, step Decimal) []Decimal {
+	first := rng.Min.Div(step).IntPart()
+
+	if factory.NewFromInt64(first).Mul(step).LessThan(rng.Min) {
+		first++
+	}
+
+	var ret []Decimal
+
+	for i := first; ; i++ {
+		value := factory.NewFromInt64(i).Mul(step)
+
+		if value.GreaterThan(rng.Max) {
+			return ret
+		}
+
+		ret = append(ret, value)
+	}
+}
+
+// tickDecimals returns the number of decimals needed to tell the values
+// apart.
+func tickDecimals(values []Decimal) int {
+	minDiff := math.Inf(1)
+
+	for i := 1; i < len(values); i++ {
+		if diff := values[i].Sub(values[i-1]).Float64(); diff > 0 && diff < minDiff {
+			minDiff = diff
+		}
+	}
+
+	if math.IsInf(minDiff, 1) {
+		return 0
+	}
+
+	// Round to hide the floating point errors of the difference.
+	decs := -int(math.Floor(math.Log10(minDiff) + 1e-9))
+	if decs < 0 {
+		decs = 0
+	}
+
+	return decs
+}
@@ -0,0 +1,244 @@
+package tplot
+
+import (
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// TimeAxis represents a horizontal axis that labels columns by their
+// timestamps. The tick interval is chosen depending on the visible time span
+// so that the labels never overlap.
+type TimeAxis struct {
+	*tview.Box
+	style   tcell.Style
+	spacing int
+	data    []time.Time
+}
+
+// NewTimeAxis creates a new instance of TimeAxis.
+func NewTimeAxis() *TimeAxis {
+	return &TimeAxis{
+		Box:     tview.NewBox(),
+		style:   tcell.StyleDefault,
+		spacing: 1,
+	}
+}
+
+// SetStyle sets the axis style.
+func (t *TimeAxis) SetStyle(style tcell.Style) {
+	t.style = style
+}
+
+// Style returns the axis style.
+func (t *TimeAxis) Style() tcell.Style {
+	return t.style
+}
+
+// SetSpacing sets the number of columns taken by each timestamp.
+func (t *TimeAxis) SetSpacing(spacing int) {
+	if spacing <= 0 {
+		spacing = 1
+	}
+
+	t.spacing = spacing
+}
+
+// Spacing returns the number of columns taken by each timestamp.
+func (t *TimeAxis) Spacing() int {
+	return t.spacing
+}
+
+// SetData sets the timestamps of the visible items. The timestamps are
+// aligned to the right edge of the axis, same as the other renderers.
+func (t *TimeAxis) SetData(data []time.Time) {
+	t.data = data
+}
+
+// Data returns the timestamps of the visible items.
+func (t *TimeAxis) Data() []time.Time {
+	return t.data
+}
+
+// Draw implements tview.Primitive.
+func (t *TimeAxis) Draw(screen tcell.Screen) {
+	t.Box.DrawForSubclass(screen, t)
+
+	x, y, w, h := t.GetInnerRect()
+	data := t.data
+	spacing := t.spacing
+
+	if h == 0 || w == 0 {
+		return
+	}
+
+	if maxCount := w / spacing; len(data) > maxCount {
+		data = data[len(data)-maxCount:]
+	}
+
+	step := chooseTimeStep(data, spacing)
+
+	// next is the first column that is not occupied by a previous label.
+	next := x
+
+	for i := 1; i < len(data); i++ {
+		ts, prev := step.truncate(data[i]), step.truncate(data[i-1])
+
+		if ts.Equal(prev) {
+			continue
+		}
+
+		label := ts.Format(step.layout(ts, prev))
+		xx := x + i*spacing + (w - len(data)*spacing)
+
+		if xx < next || xx+len(label) > x+w {
+			continue
+		}
+
+		for j, r := range label {
+			screen.SetContent(xx+j, y, r, nil, t.style)
+		}
+
+		next = xx + len(label) + 1
+	}
+}
+
+type timeUnit int
+
+const (
+	timeUnitMinute timeUnit = iota
+	timeUnitHour
+	timeUnitDay
+	timeUnitWeek
+	timeUnitMonth
+	timeUnitYear
+)
+
+// timeStep is the interval between two ticks on the TimeAxis.
+type timeStep struct {
+	unit  timeUnit
+	count int
+}
+
+// timeSteps contains all supported tick intervals, from the shortest to the
+// longest.
+var timeSteps = []timeStep{
+	{timeUnitMinute, 1},
+	{timeUnitMinute, 5},
+	{timeUnitMinute, 15},
+	{timeUnitMinute, 30},
+	{timeUnitHour, 1},
+	{timeUnitHour, 3},
+	{timeUnitHour, 6},
+	{timeUnitHour, 12},
+	{timeUnitDay, 1},
+	{timeUnitWeek, 1},
+	{timeUnitMonth, 1},
+	{timeUnitMonth, 3},
+	{timeUnitMonth, 6},
+	{timeUnitYear, 1},
+	{timeUnitYear, 5},
+	{timeUnitYear, 10},
+}
+
+// chooseTimeStep returns the shortest step for which there is enough room to
+// render the labels without overlapping.
+func chooseTimeStep(data []time.Time, spacing int) timeStep {
+	l := len(data)
+	if l < 2 {
+		return timeSteps[0]
+	}
+
+	perColumn := data[l-1].Sub(data[0]) / time.Duration((l-1)*spacing)
+	if perColumn <= 0 {
+		return timeSteps[0]
+	}
+
+	for _, step := range timeSteps {
+		if int(step.duration()/perColumn) > step.width() {
+			return step
+		}
+	}
+
+	return timeSteps[len(timeSteps)-1]
+}
+
+// duration returns the approximate duration of the step.
+func (s timeStep) duration() time.Duration {
+	d := time.Duration(s.count)
+
+	switch s.unit {
+	case timeUnitMinute:
+		return d * time.Minute
+	case timeUnitHour:
+		return d * time.Hour
+	case timeUnitDay:
+		return d * 24 * time.Hour
+	case timeUnitWeek:
+		return d * 7 * 24 * time.Hour
+	case timeUnitMonth:
+		return d * 30 * 24 * time.Hour
+	default:
+		return d * 365 * 24 * time.Hour
+	}
+}
+
+// width returns the maximum width of a label.
+func (s timeStep) width() int {
+	switch s.unit {
+	case timeUnitMonth, timeUnitYear:
+		return len("2006")
+	default:
+		return len("Jan 02")
+	}
+}
+
+// truncate rounds the time down to the beginning of the step.
+func (s timeStep) truncate(t time.Time) time.Time {
+	year, month, day := t.Date()
+	loc := t.Location()
+
+	switch s.unit {
+	case timeUnitMinute:
+		minute := t.Minute()
+		return time.Date(year, month, day, t.Hour(), minute-minute%s.count, 0, 0, loc)
+	case timeUnitHour:
+		hour := t.Hour()
+		return time.Date(year, month, day, hour-hour%s.count, 0, 0, 0, loc)
+	case timeUnitDay:
+		return time.Date(year, month, day, 0, 0, 0, 0, loc)
+	case timeUnitWeek:
+		// Weeks start on Monday.
+		weekday := (int(t.Weekday()) + 6) % 7
+		return time.Date(year, month, day-weekday, 0, 0, 0, 0, loc)
+	case timeUnitMonth:
+		return time.Date(year, month-(month-1)%time.Month(s.count), 1, 0, 0, 0, 0, loc)
+	default:
+		return time.Date(year-year%s.count, 1, 1, 0, 0, 0, 0, loc)
+	}
+}
+
+// layout returns the time layout for the label at ts, given the previous
+// tick. Labels that cross a larger unit boundary (e.g. a new day when ticks
+// are in hours) use a more descriptive layout.
+func (s timeStep) layout(ts, prev time.Time) string {
+	switch s.unit {
+	case timeUnitMinute, timeUnitHour:
+		if ts.YearDay() != prev.YearDay() || ts.Year() != prev.Year() {
+			return "Jan 02"
+		}
+
+		return "15:04"
+	case timeUnitDay, timeUnitWeek:
+		return "Jan 02"
+	case timeUnitMonth:
+		if ts.Year() != prev.Year() {
+			return "2006"
+		}
+
+		return "Jan"
+	default:
+		return "2006"
+	}
+}
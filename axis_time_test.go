@@ -0,0 +1,64 @@
+package tplot_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jeremija/tplot"
+	"github.com/jeremija/tplot/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeAxis(t *testing.T) {
+	p := tplot.NewTimeAxis()
+	scr := test.NewScreen()
+
+	start := time.Date(2020, 1, 1, 9, 50, 0, 0, time.UTC)
+	data := make([]time.Time, 30)
+
+	for i := range data {
+		data[i] = start.Add(time.Duration(i) * time.Minute)
+	}
+
+	p.SetRect(0, 0, 30, 1)
+	p.SetData(data)
+	p.Draw(scr)
+
+	exp := "          10:00          10:15"
+
+	fmt.Println("== expected ==")
+	fmt.Println(exp)
+	fmt.Println("==  actual  ==")
+	fmt.Println(scr.Content())
+	fmt.Println("==============")
+
+	assert.Equal(t, exp, scr.Content())
+}
+
+func TestTimeAxis_days(t *testing.T) {
+	p := tplot.NewTimeAxis()
+	scr := test.NewScreen()
+
+	start := time.Date(2020, 1, 1, 20, 0, 0, 0, time.UTC)
+	data := make([]time.Time, 20)
+
+	for i := range data {
+		data[i] = start.Add(time.Duration(i) * time.Hour)
+	}
+
+	p.SetRect(0, 0, 40, 1)
+	p.SetSpacing(2)
+	p.SetData(data)
+	p.Draw(scr)
+
+	exp := "        Jan 02      06:00       12:00"
+
+	fmt.Println("== expected ==")
+	fmt.Println(exp)
+	fmt.Println("==  actual  ==")
+	fmt.Println(scr.Content())
+	fmt.Println("==============")
+
+	assert.Equal(t, exp, scr.Content())
+}
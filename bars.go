@@ -4,32 +4,72 @@ import (
 	"github.com/gdamore/tcell/v2"
 )
 
+// Bars is a bar chart where the bars grow from the baseline, which is zero by
+// default. Positive values grow up and negative values grow down from the
+// baseline.
 type Bars struct {
 	*base
+
+	baseline      Decimal
+	negativeStyle tcell.Style
 }
 
 var DefaultBarsRunes = []rune{'▃', '▄', '▆', '█'}
 
 func NewBars(factory DecimalFactory) *Bars {
 	return &Bars{
-		base: newBase(factory, DefaultBarsRunes),
+		base:     newBase(factory, DefaultBarsRunes),
+		baseline: factory.Zero(),
 	}
 }
 
+// SetBaseline sets the value the bars grow from. The baseline is always
+// within the range of the scale.
+func (b *Bars) SetBaseline(baseline Decimal) {
+	b.baseline = baseline
+}
+
+// Baseline returns the value the bars grow from.
+func (b *Bars) Baseline() Decimal {
+	return b.baseline
+}
+
+// SetNegativeStyle sets the style of the bars with values below the
+// baseline.
+func (b *Bars) SetNegativeStyle(style tcell.Style) {
+	b.negativeStyle = style
+}
+
+// NegativeStyle returns the style of the bars with values below the baseline.
+func (b *Bars) NegativeStyle() tcell.Style {
+	return b.negativeStyle
+}
+
+// calcRange returns the range of values including the baseline.
+func (b *Bars) calcRange(data DataSource) Range {
+	return b.base.calcRange(data).Feed(b.baseline)
+}
+
 func (b *Bars) Draw(screen tcell.Screen) {
 	b.DrawForSubclass(screen, b)
 
-	data := b.DataSlice()
+	data, start := b.dataWindow()
 	scale := b.scale
 	spacing := b.spacing
 	runes := b.runes
-	style := b.style
 	x, y, w, h := b.GetInnerRect()
 
 	if h == 0 || w == 0 {
 		return
 	}
 
+	if b.renderMode == RenderBraille {
+		b.drawBraille(screen, data)
+		b.drawAnnotations(screen, start, data.Len())
+
+		return
+	}
+
 	if len(runes) == 0 {
 		runes = []rune{'█'}
 	}
@@ -42,29 +82,96 @@ func (b *Bars) Draw(screen tcell.Screen) {
 	scale.SetRange(rng)
 
 	// If we're sharing the scale with other components that can't use the
-	// fractions.
+	// fractions. One more place so that the maximum fills the whole height.
 	scale = scale.Copy()
-	scale.SetSize(h * numFractions)
+	scale.SetSize(h*numFractions + 1)
 
-	for i, dec := range data {
-		v := scale.Value(dec)
-		xx := x + i*spacing + (w - len(data)*spacing)
+	// The baseline is rounded to the nearest row boundary so that the bars
+	// on both sides start at a whole cell.
+	baseline := (scale.Value(b.baseline) + numFractions/2) / numFractions
 
-		fullSteps := v / numFractions
-		rem := v % numFractions
+	l := data.Len()
 
-		fullBlock := runes[len(runes)-1]
+	for i := 0; i < l; i++ {
+		dec := data.At(i)
+		v := scale.Value(dec)
+		xx := x + i*spacing + (w - l*spacing)
 
-		for j := 1; j <= fullSteps; j++ {
-			yy := y + h - j
-			screen.SetContent(xx, yy, fullBlock, nil, style)
+		if dec.LessThan(b.baseline) {
+			drawBarDown(screen, xx, y+h-baseline, baseline*numFractions-v, runes, b.negativeStyle)
+		} else {
+			b.drawUp(screen, xx, y+h-baseline-1, v-baseline*numFractions, runes)
 		}
+	}
 
-		if rem > 0 {
-			ch := runes[rem-1]
+	b.drawAnnotations(screen, start, l)
+}
+
+// drawUp draws a bar of size fractions of a cell upwards starting at the row
+// yy.
+func (b *Bars) drawUp(screen tcell.Screen, xx, yy, size int, runes []rune) {
+	numFractions := len(runes)
+	fullSteps := size / numFractions
+	rem := size % numFractions
 
-			yy := y + h - fullSteps - 1
-			screen.SetContent(xx, yy, ch, nil, style)
+	fullBlock := runes[numFractions-1]
+
+	for j := 0; j < fullSteps; j++ {
+		screen.SetContent(xx, yy-j, fullBlock, nil, b.style)
+	}
+
+	if rem > 0 {
+		screen.SetContent(xx, yy-fullSteps, runes[rem-1], nil, b.style)
+	}
+}
+
+// drawBarDown draws a bar of size fractions of a cell downwards starting at
+// the row yy. The fractions are drawn by reversing the style of the runes, so
+// that the empty part of the rune is drawn in the foreground color.
+func drawBarDown(screen tcell.Screen, xx, yy, size int, runes []rune, style tcell.Style) {
+	numFractions := len(runes)
+	fullSteps := size / numFractions
+	rem := size % numFractions
+
+	fullBlock := runes[numFractions-1]
+
+	for j := 0; j < fullSteps; j++ {
+		screen.SetContent(xx, yy+j, fullBlock, nil, style)
+	}
+
+	if rem > 0 {
+		ch := runes[numFractions-rem-1]
+
+		screen.SetContent(xx, yy+fullSteps, ch, nil, style.Reverse(true))
+	}
+}
+
+func (b *Bars) drawBraille(screen tcell.Screen, data DataSource) {
+	x, y, _, _ := b.GetInnerRect()
+	canvas, scale := b.brailleCanvas(b.calcRange(data))
+	dotW, dotH := canvas.DotSize()
+
+	baseline := scale.Value(b.baseline)
+
+	l := data.Len()
+
+	for i := 0; i < l; i++ {
+		dec := data.At(i)
+		v := scale.Value(dec)
+		xx := i*b.spacing + (dotW - l*b.spacing)
+
+		if dec.LessThan(b.baseline) {
+			for j := v; j < baseline; j++ {
+				canvas.Point(xx, dotH-j-1, b.negativeStyle)
+			}
+
+			continue
+		}
+
+		for j := baseline; j < v; j++ {
+			canvas.Point(xx, dotH-j-1, b.style)
 		}
 	}
+
+	canvas.Draw(screen, x, y)
 }
@@ -0,0 +1,203 @@
+package tplot
+
+import (
+	"unicode/utf8"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// HorizontalBars is a bar chart where each data item is a row, labeled on the
+// left, and the bars grow from left to right. The bars start at zero, so the
+// lengths of the bars are proportional to their values. Negative values are
+// drawn as empty bars. Like a ranking, the first items are kept when there are
+// more items than rows, see SetSliceMethod. Only RenderRunes is supported.
+//
+// The values run from left to right, so the HLines are drawn as vertical
+// rules at their values, with the labels at the top. Their values are not
+// labeled on the horizontal axis. The VMarkers are not drawn.
+type HorizontalBars struct {
+	*base
+
+	labels     []string
+	labelStyle tcell.Style
+
+	// plot is the rect of the bars during the last Draw.
+	plot rect
+}
+
+var _ PlotArea = &HorizontalBars{}
+
+// DefaultHorizontalBarsRunes contains the block characters used to draw the
+// eighths of a cell.
+var DefaultHorizontalBarsRunes = []rune{'▏', '▎', '▍', '▌', '▋', '▊', '▉', '█'}
+
+// NewHorizontalBars creates a new instance of HorizontalBars.
+func NewHorizontalBars(factory DecimalFactory) *HorizontalBars {
+	b := &HorizontalBars{
+		base:       newBase(factory, DefaultHorizontalBarsRunes),
+		labelStyle: tcell.StyleDefault,
+	}
+
+	b.sliceMethod = First
+
+	return b
+}
+
+// SetRenderMode sets the render mode. RenderBraille is not supported and
+// leaves the mode unchanged.
+func (b *HorizontalBars) SetRenderMode(mode RenderMode) {
+	if mode == RenderBraille {
+		return
+	}
+
+	b.base.SetRenderMode(mode)
+}
+
+// SetLabels sets the labels of the rows. Each label belongs to the data item
+// at the same index.
+func (b *HorizontalBars) SetLabels(labels []string) {
+	b.labels = labels
+}
+
+// Labels returns the labels of the rows.
+func (b *HorizontalBars) Labels() []string {
+	return b.labels
+}
+
+// SetLabelStyle sets the style of the labels.
+func (b *HorizontalBars) SetLabelStyle(style tcell.Style) {
+	b.labelStyle = style
+}
+
+// LabelStyle returns the style of the labels.
+func (b *HorizontalBars) LabelStyle() tcell.Style {
+	return b.labelStyle
+}
+
+// PlotRect implements PlotArea.
+func (b *HorizontalBars) PlotRect() (int, int, int, int) {
+	return b.plot.x, b.plot.y, b.plot.w, b.plot.h
+}
+
+// DataSlice returns data, but only the items that fit on the screen. Each
+// item takes spacing rows.
+func (b *HorizontalBars) DataSlice() []Decimal {
+	_, _, _, h := b.GetInnerRect()
+
+	data, _ := b.sliceData(h / b.spacing)
+
+	return decimals(data)
+}
+
+// label returns the label of the item at index i, or an empty string.
+func (b *HorizontalBars) label(i int) string {
+	if i < 0 || i >= len(b.labels) {
+		return ""
+	}
+
+	return b.labels[i]
+}
+
+// Draw implements tview.Primitive.
+func (b *HorizontalBars) Draw(screen tcell.Screen) {
+	b.DrawForSubclass(screen, b)
+
+	x, y, w, h := b.GetInnerRect()
+	spacing := b.spacing
+	runes := b.runes
+	style := b.style
+
+	b.plot = rect{x: x, y: y}
+
+	if h == 0 || w == 0 {
+		return
+	}
+
+	data, start := b.sliceData(h / spacing)
+	l := data.Len()
+
+	labelW := 0
+
+	for i := 0; i < l; i++ {
+		if lw := utf8.RuneCountInString(b.label(start + i)); lw > labelW {
+			labelW = lw
+		}
+	}
+
+	if labelW > 0 {
+		// Leave a space between the labels and the bars.
+		labelW++
+	}
+
+	// Hide labels when there's no room for the bars.
+	if labelW >= w {
+		labelW = 0
+	}
+
+	barsW := w - labelW
+
+	b.plot = rect{x: x + labelW, y: y, w: barsW, h: h}
+
+	if len(runes) == 0 {
+		runes = []rune{'█'}
+	}
+
+	numFractions := len(runes)
+
+	rng := b.calcRange(data).Feed(b.factory.Zero())
+
+	b.scale.SetRange(rng)
+	// The shared scale is used by a horizontal axis.
+	b.scale.SetSize(barsW)
+
+	// One more place so that the maximum fills the whole width.
+	scale := b.scale.Copy()
+	scale.SetSize(barsW*numFractions + 1)
+
+	fullBlock := runes[len(runes)-1]
+
+	for i := 0; i < l; i++ {
+		yy := y + i*spacing
+
+		if labelW > 0 {
+			for j, ch := range []rune(b.label(start + i)) {
+				screen.SetContent(x+j, yy, ch, nil, b.labelStyle)
+			}
+		}
+
+		v := scale.Value(data.At(i))
+		if v < 0 {
+			v = 0
+		}
+
+		fullSteps := v / numFractions
+		rem := v % numFractions
+
+		for j := 0; j < fullSteps; j++ {
+			screen.SetContent(b.plot.x+j, yy, fullBlock, nil, style)
+		}
+
+		if rem > 0 {
+			screen.SetContent(b.plot.x+fullSteps, yy, runes[rem-1], nil, style)
+		}
+	}
+
+	b.drawLines(screen)
+}
+
+// drawLines draws the lines as vertical rules over the empty cells of the
+// bars.
+func (b *HorizontalBars) drawLines(screen tcell.Screen) {
+	for _, line := range b.hlines {
+		if line.Value == nil {
+			continue
+		}
+
+		if col := b.scale.Value(line.Value); col >= 0 && col < b.plot.w {
+			drawVMarker(screen, b.plot, b.plot.x+col, VMarker{
+				Label: line.Label,
+				Style: line.Style,
+			})
+		}
+	}
+}
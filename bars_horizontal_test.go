@@ -0,0 +1,122 @@
+package tplot_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/jeremija/tplot"
+	"github.com/jeremija/tplot/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHorizontalBars(t *testing.T) {
+	var factory tplot.FloatFactory
+
+	p := tplot.NewHorizontalBars(factory)
+	a := tplot.NewAxis(factory)
+	box := tplot.NewAxisBox(a, p)
+	box.SetPosition(tplot.Bottom)
+	scr := test.NewScreen()
+
+	data := []tplot.Decimal{
+		tplot.Float(100),
+		tplot.Float(50),
+		tplot.Float(25),
+		tplot.Float(12.5),
+	}
+
+	p.SetData(data)
+	p.SetLabels([]string{"/api", "/login", "/", "/health"})
+
+	box.SetRect(0, 0, 24, 5)
+	box.Draw(scr)
+
+	exp := `
+/api    ████████████████
+/login  ████████
+/       ████
+/health ██
+        0      50    100`
+
+	fmt.Println("== expected ==")
+	fmt.Println(exp)
+	fmt.Println("==  actual  ==")
+	fmt.Println(scr.Content())
+	fmt.Println("==============")
+
+	assert.Equal(t, exp, "\n"+scr.Content())
+
+	x, y, w, h := p.PlotRect()
+	assert.Equal(t, []int{8, 0, 16, 4}, []int{x, y, w, h})
+
+	box.SetPosition(tplot.Top)
+	p.SetSpacing(2)
+	p.SetLabels(nil)
+	a.SetHighlight(tplot.DecimalValue{
+		Decimal: tplot.Float(25),
+		Valid:   true,
+	})
+
+	scr = test.NewScreen()
+	box.Draw(scr)
+
+	// The first items are kept by default.
+	exp = `
+0  25.00 40  60   80 100
+████████████████████████
+
+████████████
+`
+
+	assert.Equal(t, exp, "\n"+scr.Content())
+	assert.Equal(t, []tplot.Decimal{tplot.Float(100), tplot.Float(50)}, p.DataSlice())
+
+	p.SetSliceMethod(tplot.Last)
+
+	scr = test.NewScreen()
+	box.Draw(scr)
+
+	exp = `
+0   5    10  15    25.00
+████████████████████████
+
+████████████
+`
+
+	assert.Equal(t, exp, "\n"+scr.Content())
+	assert.Equal(t, []tplot.Decimal{tplot.Float(25), tplot.Float(12.5)}, p.DataSlice())
+
+	p.SetRenderMode(tplot.RenderBraille)
+	assert.Equal(t, tplot.RenderRunes, p.RenderMode(), "braille is not supported")
+}
+
+func TestHorizontalBars_hlines(t *testing.T) {
+	var factory tplot.FloatFactory
+
+	p := tplot.NewHorizontalBars(factory)
+	p.SetData([]tplot.Decimal{tplot.Float(1), tplot.Float(4)})
+
+	style := tcell.StyleDefault.Foreground(tcell.ColorRed)
+	p.SetHLines([]tplot.HLine{{Value: tplot.Float(2), Label: "slo", Style: style}})
+	p.SetRect(0, 0, 9, 3)
+
+	scr := test.NewScreen()
+	p.Draw(scr)
+
+	// The line is drawn beneath the bars.
+	exp := `
+██▎ ╎slo
+█████████
+    ╎`
+
+	fmt.Println("== expected ==")
+	fmt.Println(exp)
+	fmt.Println("==  actual  ==")
+	fmt.Println(scr.Content())
+	fmt.Println("==============")
+
+	assert.Equal(t, exp, "\n"+scr.Content())
+	assert.Equal(t, style, scr.Style(4, 2), "line")
+	assert.Equal(t, style, scr.Style(5, 0), "label")
+}
@@ -0,0 +1,244 @@
+package tplot
+
+import (
+	"github.com/gdamore/tcell/v2"
+)
+
+// BarsMode describes how MultiBars draws multiple series.
+type BarsMode int
+
+const (
+	// BarsStacked draws the values of all series on top of each other in a
+	// single column.
+	BarsStacked BarsMode = iota
+	// BarsGrouped draws the values of all series side by side, one column
+	// per series.
+	BarsGrouped
+)
+
+// DefaultMultiBarsRunes contains the block characters used to draw the
+// eighths of a cell.
+var DefaultMultiBarsRunes = []rune{'▁', '▂', '▃', '▄', '▅', '▆', '▇', '█'}
+
+// MultiBars is a bar chart that draws multiple series, each in its own
+// style. By default the data is drawn as a single series in the style of the
+// MultiBars. The bars start at zero and negative values are drawn as empty
+// bars. Series shorter than the others are padded with zeros at the end.
+//
+// Each item takes one column in the stacked mode, and one column per series
+// in the grouped mode, followed by spacing-1 empty columns. Only RenderRunes
+// is supported.
+type MultiBars struct {
+	*base
+
+	series []Series
+	mode   BarsMode
+}
+
+// NewMultiBars creates a new instance of MultiBars.
+func NewMultiBars(factory DecimalFactory) *MultiBars {
+	return &MultiBars{
+		base: newBase(factory, DefaultMultiBarsRunes),
+	}
+}
+
+// SetSeries sets the series, which are drawn instead of the data.
+func (b *MultiBars) SetSeries(series []Series) {
+	b.series = series
+}
+
+// Series returns the series.
+func (b *MultiBars) Series() []Series {
+	return b.series
+}
+
+// SetMode sets how the series are drawn.
+func (b *MultiBars) SetMode(mode BarsMode) {
+	b.mode = mode
+}
+
+// Mode returns how the series are drawn.
+func (b *MultiBars) Mode() BarsMode {
+	return b.mode
+}
+
+// SetRenderMode sets the render mode. RenderBraille is not supported and
+// leaves the mode unchanged.
+func (b *MultiBars) SetRenderMode(mode RenderMode) {
+	if mode == RenderBraille {
+		return
+	}
+
+	b.base.SetRenderMode(mode)
+}
+
+// barWidth returns the number of columns taken by the bars of a single item.
+func (b *MultiBars) barWidth() int {
+	if b.mode == BarsGrouped && len(b.series) > 0 {
+		return len(b.series)
+	}
+
+	return 1
+}
+
+// slot returns the number of columns taken by a single item.
+func (b *MultiBars) slot() int {
+	return b.barWidth() + b.spacing - 1
+}
+
+// DataSlice returns data, but only the items that fit on the screen.
+func (b *MultiBars) DataSlice() []Decimal {
+	_, _, w, _ := b.GetInnerRect()
+
+	data, _ := b.sliceData(w / b.slot())
+
+	return decimals(data)
+}
+
+// calcRange returns the range from zero to the largest value, or the largest
+// total in the stacked mode, including the horizontal lines.
+func (b *MultiBars) calcRange(values [][]Decimal) Range {
+	zero := b.factory.Zero()
+	rng := NewRange(b.factory).Feed(zero)
+
+	for i := range values[0] {
+		total := zero
+
+		for _, layer := range values {
+			if b.mode == BarsGrouped {
+				rng = rng.Feed(layer[i])
+			} else {
+				total = total.Add(layer[i])
+			}
+		}
+
+		rng = rng.Feed(total)
+	}
+
+	for _, value := range hlineValues(b.hlines) {
+		rng = rng.Feed(value)
+	}
+
+	return rng
+}
+
+// Draw implements tview.Primitive.
+func (b *MultiBars) Draw(screen tcell.Screen) {
+	b.DrawForSubclass(screen, b)
+
+	x, y, w, h := b.GetInnerRect()
+
+	if h == 0 || w == 0 {
+		return
+	}
+
+	runes := b.runes
+	if len(runes) == 0 {
+		runes = []rune{'█'}
+	}
+
+	numFractions := len(runes)
+
+	slot := b.slot()
+	values, styles, start := b.layers(b.series, w/slot)
+
+	// The data is drawn like a single series, with negative values as zeros.
+	if len(b.series) == 0 {
+		for i, value := range values[0] {
+			if value.LessThan(b.factory.Zero()) {
+				values[0][i] = b.factory.Zero()
+			}
+		}
+	}
+
+	b.scale.SetRange(b.calcRange(values))
+	// If we're sharing the scale with other components that can't use the
+	// fractions. One more place so that the maximum fills the whole height.
+	scale := b.scale.Copy()
+	scale.SetSize(h*numFractions + 1)
+
+	l := len(values[0])
+
+	for i := 0; i < l; i++ {
+		xx := x + i*slot + (w - l*slot)
+
+		if b.mode == BarsGrouped {
+			for j, layer := range values {
+				v := scale.Value(layer[i])
+
+				drawStackedColumn(screen, xx+j, y+h-1, runes, []int{v}, []tcell.Style{styles[j]})
+			}
+
+			continue
+		}
+
+		tops := make([]int, len(values))
+		total := b.factory.Zero()
+
+		for j, layer := range values {
+			total = total.Add(layer[i])
+			tops[j] = scale.Value(total)
+		}
+
+		drawStackedColumn(screen, xx, y+h-1, runes, tops, styles)
+	}
+
+	b.drawAnnotationsSlot(screen, start, l, slot)
+}
+
+// drawStackedColumn draws the stacked segments of a column from the bottom
+// row upwards. Each segment ends at its top, in fractions of a cell. When a
+// cell contains the boundary of two segments, the lower segment is drawn in
+// the foreground and the upper segment in the background color.
+func drawStackedColumn(
+	screen tcell.Screen,
+	x, bottom int,
+	runes []rune,
+	tops []int,
+	styles []tcell.Style,
+) {
+	numFractions := len(runes)
+
+	// owner returns the index of the segment at fraction u, or -1.
+	owner := func(u int) int {
+		for j, top := range tops {
+			if u < top {
+				return j
+			}
+		}
+
+		return -1
+	}
+
+	max := 0
+
+	for _, top := range tops {
+		if top > max {
+			max = top
+		}
+	}
+
+	for row := 0; row*numFractions < max; row++ {
+		u := row * numFractions
+
+		lower := owner(u)
+		if lower < 0 {
+			continue
+		}
+
+		filled := tops[lower] - u
+		style := styles[lower]
+
+		if filled >= numFractions {
+			screen.SetContent(x, bottom-row, runes[numFractions-1], nil, style)
+			continue
+		}
+
+		if upper := owner(u + filled); upper >= 0 {
+			fg, _, _ := styles[upper].Decompose()
+			style = style.Background(fg)
+		}
+
+		screen.SetContent(x, bottom-row, runes[filled-1], nil, style)
+	}
+}
@@ -0,0 +1,113 @@
+package tplot_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/jeremija/tplot"
+	"github.com/jeremija/tplot/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMultiBars(t *testing.T) {
+	var factory tplot.FloatFactory
+
+	p := tplot.NewMultiBars(factory)
+	a := tplot.NewAxis(factory)
+	box := tplot.NewAxisBox(a, p)
+	box.SetPosition(tplot.Left)
+
+	p.SetSeries([]tplot.Series{
+		{
+			Name:  "GET",
+			Style: tcell.StyleDefault.Foreground(tcell.ColorGreen),
+			Data:  []tplot.Decimal{tplot.Float(4), tplot.Float(2), tplot.Float(1)},
+		},
+		{
+			Name:  "POST",
+			Style: tcell.StyleDefault.Foreground(tcell.ColorBlue),
+			Data:  []tplot.Decimal{tplot.Float(4), tplot.Float(1)},
+		},
+	})
+
+	p.SetSpacing(2)
+
+	scr := test.NewScreen()
+	box.SetRect(0, 0, 9, 5)
+	box.Draw(scr)
+
+	exp := `
+   █
+   █
+ 5 ▄
+   █ ▂
+ 0 █ █ ▅`
+
+	fmt.Println("== expected ==")
+	fmt.Println(exp)
+	fmt.Println("==  actual  ==")
+	fmt.Println(scr.Content())
+	fmt.Println("==============")
+
+	assert.Equal(t, exp, "\n"+scr.Content())
+
+	p.SetMode(tplot.BarsGrouped)
+	p.SetSpacing(1)
+
+	scr = test.NewScreen()
+	box.Draw(scr)
+
+	exp = `
+ 4 ██
+   ██
+ 2 ██▄
+   ███▂▂
+ 0 █████`
+
+	fmt.Println("== expected ==")
+	fmt.Println(exp)
+	fmt.Println("==  actual  ==")
+	fmt.Println(scr.Content())
+	fmt.Println("==============")
+
+	assert.Equal(t, exp, "\n"+scr.Content())
+}
+
+func TestMultiBars_source(t *testing.T) {
+	var factory tplot.FloatFactory
+
+	source := tplot.NewRingBuffer(3)
+
+	for _, v := range []float64{9, 1, -2, 3} {
+		source.Push(tplot.Float(v))
+	}
+
+	p := tplot.NewMultiBars(factory)
+	p.SetSource(source)
+	p.SetHLines([]tplot.HLine{{Value: tplot.Float(4)}})
+	p.SetVMarkers([]tplot.VMarker{{Index: 2}})
+	p.SetRect(0, 0, 4, 4)
+
+	scr := test.NewScreen()
+	p.Draw(scr)
+
+	// Negative values are drawn as empty bars.
+	exp := `
+╌╌╌╎
+   █
+   █
+ █ █`
+
+	fmt.Println("== expected ==")
+	fmt.Println(exp)
+	fmt.Println("==  actual  ==")
+	fmt.Println(scr.Content())
+	fmt.Println("==============")
+
+	assert.Equal(t, exp, "\n"+scr.Content())
+	assert.Equal(t, []tplot.Decimal{tplot.Float(1), tplot.Float(-2), tplot.Float(3)}, p.DataSlice())
+
+	p.SetRenderMode(tplot.RenderBraille)
+	assert.Equal(t, tplot.RenderRunes, p.RenderMode(), "braille is not supported")
+}
@@ -0,0 +1,73 @@
+package tplot_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/jeremija/tplot"
+	"github.com/jeremija/tplot/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBars(t *testing.T) {
+	var factory tplot.FloatFactory
+
+	p := tplot.NewBars(factory)
+	scr := test.NewScreen()
+
+	p.SetData([]tplot.Decimal{
+		tplot.Float(2),
+		tplot.Float(-2),
+		tplot.Float(1.5),
+		tplot.Float(-1.5),
+		tplot.Float(1),
+		tplot.Float(0),
+	})
+	p.SetRect(0, 0, 6, 4)
+	p.Draw(scr)
+
+	exp := `
+█ ▄
+█ █ █
+ █ █
+ █ ▄`
+
+	fmt.Println("== expected ==")
+	fmt.Println(exp)
+	fmt.Println("==  actual  ==")
+	fmt.Println(scr.Content())
+	fmt.Println("==============")
+
+	assert.Equal(t, exp, "\n"+scr.Content())
+
+	// The smallest positive value is still visible.
+	p.SetData([]tplot.Decimal{
+		tplot.Float(4),
+		tplot.Float(1),
+	})
+
+	scr = test.NewScreen()
+	p.Draw(scr)
+
+	exp = `
+    █
+    █
+    █
+    ██`
+
+	assert.Equal(t, exp, "\n"+scr.Content())
+
+	p.SetBaseline(tplot.Float(2))
+
+	scr = test.NewScreen()
+	p.Draw(scr)
+
+	exp = `
+    █
+    █
+    █
+     █`
+
+	assert.Equal(t, exp, "\n"+scr.Content())
+	assert.Equal(t, tplot.Float(2), p.Baseline())
+}
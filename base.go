@@ -11,9 +11,12 @@ type base struct {
 	style       tcell.Style
 	spacing     int
 	runes       []rune
-	data        []Decimal
+	source      DataSource
 	sliceMethod SliceMethod
+	renderMode  RenderMode
 	factory     DecimalFactory
+	hlines      []HLine
+	vmarkers    []VMarker
 }
 
 // SliceMethod describes the slicing method when the number of items in the
@@ -27,12 +30,24 @@ const (
 	First
 )
 
+// RenderMode describes how the data is rendered on the screen.
+type RenderMode int
+
+const (
+	// RenderRunes renders one data point per column using the runes.
+	RenderRunes RenderMode = iota
+	// RenderBraille renders the data points on a BrailleCanvas, which doubles
+	// the horizontal and quadruples the vertical resolution.
+	RenderBraille
+)
+
 func newBase(factory DecimalFactory, runes []rune) *base {
 	return &base{
 		Box:     tview.NewBox(),
 		scale:   NewScaleLinear(factory),
 		spacing: 1,
 		runes:   runes,
+		source:  DecimalSlice(nil),
 		factory: factory,
 	}
 }
@@ -45,6 +60,14 @@ func (b *base) SliceMethod() SliceMethod {
 	return b.sliceMethod
 }
 
+func (b *base) SetRenderMode(mode RenderMode) {
+	b.renderMode = mode
+}
+
+func (b *base) RenderMode() RenderMode {
+	return b.renderMode
+}
+
 func (b *base) SetSpacing(spacing int) {
 	if spacing <= 0 {
 		spacing = 1
@@ -65,44 +88,199 @@ func (b *base) Style() tcell.Style {
 	return b.style
 }
 
-func (b *base) calcRange(values []Decimal) Range {
+func (b *base) calcRange(values DataSource) Range {
 	rng := NewRange(b.factory)
 
-	for _, dec := range values {
-		rng = rng.Feed(dec)
+	for i := 0; i < values.Len(); i++ {
+		rng = rng.Feed(values.At(i))
+	}
+
+	for _, value := range hlineValues(b.hlines) {
+		rng = rng.Feed(value)
 	}
 
 	return rng
 }
 
+// SetHLines sets the horizontal lines drawn beneath the data. The values of
+// the lines are included in the range.
+func (b *base) SetHLines(lines []HLine) {
+	b.hlines = lines
+}
+
+// HLines returns the horizontal lines.
+func (b *base) HLines() []HLine {
+	return b.hlines
+}
+
+// SetVMarkers sets the vertical markers drawn beneath the data. The markers
+// are placed at VMarker.Index, the index of the item in the source.
+// VMarker.Time is ignored, because the sources of these charts have no
+// timestamps.
+func (b *base) SetVMarkers(markers []VMarker) {
+	b.vmarkers = markers
+}
+
+// VMarkers returns the vertical markers.
+func (b *base) VMarkers() []VMarker {
+	return b.vmarkers
+}
+
+// drawAnnotations draws the vertical markers and the horizontal lines over
+// the empty cells. The l items of the source from start are visible.
+func (b *base) drawAnnotations(screen tcell.Screen, start, l int) {
+	b.drawAnnotationsSlot(screen, start, l, b.spacing)
+}
+
+// drawAnnotationsSlot is like drawAnnotations for items that take slot
+// columns, or dots in RenderBraille.
+func (b *base) drawAnnotationsSlot(screen tcell.Screen, start, l, slot int) {
+	x, y, w, h := b.GetInnerRect()
+
+	if w == 0 || h == 0 {
+		return
+	}
+
+	r := rect{x: x, y: y, w: w, h: h}
+
+	scale := b.scale.Copy()
+	scale.SetSize(h)
+
+	// The markers are drawn first so that their labels on the top row are
+	// not hidden by a line.
+	for _, marker := range b.vmarkers {
+		i := marker.Index - start
+		if i < 0 || i >= l {
+			continue
+		}
+
+		xx := x + i*slot + (w - l*slot)
+
+		if b.renderMode == RenderBraille {
+			xx = x + (i*slot+(w*brailleDotsX-l*slot))/brailleDotsX
+		}
+
+		drawVMarker(screen, r, xx, marker)
+	}
+
+	for _, line := range b.hlines {
+		if line.Value == nil {
+			continue
+		}
+
+		if row := scale.Value(line.Value); row >= 0 && row < h {
+			drawHLine(screen, r, y+h-row-1, line)
+		}
+	}
+}
+
+// Data returns the data. The data is copied when the source is not a
+// DecimalSlice, see SetSource.
 func (b *base) Data() []Decimal {
-	return b.data
+	return decimals(b.source)
 }
 
 // DataSlice returns data, but only the items that
 // fit on the screen.
 func (b *base) DataSlice() []Decimal {
+	data, _ := b.dataWindow()
+
+	return decimals(data)
+}
+
+// dataWindow returns the items from source that fit on the screen, without
+// copying them, and the index of the first returned item in source.
+func (b *base) dataWindow() (DataSource, int) {
 	_, _, w, _ := b.GetInnerRect()
-	maxCount := w / b.spacing
-	data := b.data
 
-	if l := len(data); l > maxCount {
+	if b.renderMode == RenderBraille {
+		w *= brailleDotsX
+	}
+
+	return b.sliceData(w / b.spacing)
+}
+
+// sliceData returns up to maxCount items from source depending on the slice
+// method, and the index of the first returned item in source.
+func (b *base) sliceData(maxCount int) (DataSource, int) {
+	data := b.source
+
+	if l := data.Len(); l > maxCount {
+		if b.sliceMethod == Last {
+			return window(data, l-maxCount, maxCount), l - maxCount
+		}
+
+		return window(data, 0, maxCount), 0
+	}
+
+	return data, 0
+}
+
+// layers returns the values of each series that fit in maxCount items, the
+// style of each series and the index of the first item. Negative values of
+// the series are replaced with zeros. Without series, the data is returned as
+// a single layer in the style of b.
+func (b *base) layers(series []Series, maxCount int) ([][]Decimal, []tcell.Style, int) {
+	if len(series) == 0 {
+		data, start := b.sliceData(maxCount)
+
+		return [][]Decimal{decimals(data)}, []tcell.Style{b.style}, start
+	}
+
+	n := seriesLen(series)
+	start, end := 0, n
+
+	if n > maxCount {
 		if b.sliceMethod == Last {
-			data = data[l-maxCount:]
+			start = n - maxCount
 		} else {
-			data = data[:maxCount]
+			end = maxCount
 		}
 	}
 
-	return data
+	zero := b.factory.Zero()
+
+	values := make([][]Decimal, len(series))
+	styles := make([]tcell.Style, len(series))
+
+	for j, s := range series {
+		values[j] = make([]Decimal, end-start)
+		styles[j] = s.Style
+
+		for i := range values[j] {
+			value := seriesValue(b.factory, s, start+i)
+			if value.LessThan(zero) {
+				value = zero
+			}
+
+			values[j][i] = value
+		}
+	}
+
+	return values, styles, start
 }
 
 func (b *base) SetData(data []Decimal) {
-	b.data = data
+	b.source = DecimalSlice(data)
 }
 
 func (b *base) Values() []Decimal {
-	return b.data
+	return b.Data()
+}
+
+// SetSource sets the source of the data, for example a RingBuffer. The values
+// are read from the source on every Draw.
+func (b *base) SetSource(source DataSource) {
+	if source == nil {
+		source = DecimalSlice(nil)
+	}
+
+	b.source = source
+}
+
+// Source returns the source of the data.
+func (b *base) Source() DataSource {
+	return b.source
 }
 
 func (b *base) SetScale(scale Scale) {
@@ -120,3 +298,21 @@ func (b *base) SetRunes(runes []rune) {
 func (b *base) Runes() []rune {
 	return b.runes
 }
+
+// brailleCanvas creates a BrailleCanvas covering the inner rect, and a copy
+// of the scale with the range rng and the size of canvas height in dots.
+func (b *base) brailleCanvas(rng Range) (*BrailleCanvas, Scale) {
+	_, _, w, h := b.GetInnerRect()
+
+	canvas := NewBrailleCanvas(w, h)
+	_, dotH := canvas.DotSize()
+
+	scale := b.scale
+	scale.SetRange(rng)
+	// If we're sharing the scale with other components that can't use the
+	// dots.
+	scale = scale.Copy()
+	scale.SetSize(dotH)
+
+	return canvas, scale
+}
@@ -0,0 +1,200 @@
+package tplot
+
+import (
+	"github.com/gdamore/tcell/v2"
+)
+
+const (
+	// brailleDotsX is the number of horizontal dots in a braille cell.
+	brailleDotsX = 2
+	// brailleDotsY is the number of vertical dots in a braille cell.
+	brailleDotsY = 4
+	// brailleBlank is the braille pattern without any dots.
+	brailleBlank = '⠀'
+)
+
+// brailleBits contains the bit for each dot in a braille cell, indexed by x
+// and y. See https://en.wikipedia.org/wiki/Braille_Patterns.
+var brailleBits = [brailleDotsX][brailleDotsY]rune{
+	{0x01, 0x02, 0x04, 0x40},
+	{0x08, 0x10, 0x20, 0x80},
+}
+
+// BrailleCanvas is a drawing surface with a grid of 2x4 dots per terminal
+// cell, rendered using the Unicode braille patterns. Dot coordinates start at
+// the top left corner, same as the screen coordinates. Each cell can have its
+// own style.
+type BrailleCanvas struct {
+	width  int
+	height int
+	cells  []rune
+	styles []tcell.Style
+}
+
+// NewBrailleCanvas creates a new BrailleCanvas with the size in cells.
+func NewBrailleCanvas(width, height int) *BrailleCanvas {
+	c := &BrailleCanvas{}
+	c.Resize(width, height)
+
+	return c
+}
+
+// Resize sets the canvas size in cells and clears it.
+func (c *BrailleCanvas) Resize(width, height int) {
+	if width < 0 {
+		width = 0
+	}
+
+	if height < 0 {
+		height = 0
+	}
+
+	c.width = width
+	c.height = height
+	c.cells = make([]rune, width*height)
+	c.styles = make([]tcell.Style, width*height)
+}
+
+// Clear removes all dots and styles from the canvas.
+func (c *BrailleCanvas) Clear() {
+	for i := range c.cells {
+		c.cells[i] = 0
+		c.styles[i] = tcell.StyleDefault
+	}
+}
+
+// Size returns the canvas size in cells.
+func (c *BrailleCanvas) Size() (width, height int) {
+	return c.width, c.height
+}
+
+// DotSize returns the canvas size in dots.
+func (c *BrailleCanvas) DotSize() (width, height int) {
+	return c.width * brailleDotsX, c.height * brailleDotsY
+}
+
+// index returns the index of the cell containing the dot and the bit of the
+// dot within the cell. It returns false when the dot is out of bounds.
+func (c *BrailleCanvas) index(x, y int) (int, rune, bool) {
+	if x < 0 || y < 0 {
+		return 0, 0, false
+	}
+
+	cx, cy := x/brailleDotsX, y/brailleDotsY
+
+	if cx >= c.width || cy >= c.height {
+		return 0, 0, false
+	}
+
+	return cy*c.width + cx, brailleBits[x%brailleDotsX][y%brailleDotsY], true
+}
+
+// Set sets the dot at x, y. Dots outside of the canvas are ignored.
+func (c *BrailleCanvas) Set(x, y int) {
+	if i, bit, ok := c.index(x, y); ok {
+		c.cells[i] |= bit
+	}
+}
+
+// Unset removes the dot at x, y.
+func (c *BrailleCanvas) Unset(x, y int) {
+	if i, bit, ok := c.index(x, y); ok {
+		c.cells[i] &^= bit
+	}
+}
+
+// IsSet returns true when the dot at x, y is set.
+func (c *BrailleCanvas) IsSet(x, y int) bool {
+	i, bit, ok := c.index(x, y)
+
+	return ok && c.cells[i]&bit != 0
+}
+
+// SetCellStyle sets the style of the cell at cx, cy. Note that the
+// coordinates are in cells, not dots.
+func (c *BrailleCanvas) SetCellStyle(cx, cy int, style tcell.Style) {
+	if cx < 0 || cy < 0 || cx >= c.width || cy >= c.height {
+		return
+	}
+
+	c.styles[cy*c.width+cx] = style
+}
+
+// CellStyle returns the style of the cell at cx, cy.
+func (c *BrailleCanvas) CellStyle(cx, cy int) tcell.Style {
+	if cx < 0 || cy < 0 || cx >= c.width || cy >= c.height {
+		return tcell.StyleDefault
+	}
+
+	return c.styles[cy*c.width+cx]
+}
+
+// Point sets the dot at x, y and the style of the cell containing it.
+func (c *BrailleCanvas) Point(x, y int, style tcell.Style) {
+	if i, bit, ok := c.index(x, y); ok {
+		c.cells[i] |= bit
+		c.styles[i] = style
+	}
+}
+
+// Line draws a line between two dots using Bresenham's algorithm.
+func (c *BrailleCanvas) Line(x0, y0, x1, y1 int, style tcell.Style) {
+	dx := abs(x1 - x0)
+	dy := -abs(y1 - y0)
+
+	sx, sy := 1, 1
+
+	if x0 > x1 {
+		sx = -1
+	}
+
+	if y0 > y1 {
+		sy = -1
+	}
+
+	e := dx + dy
+
+	for {
+		c.Point(x0, y0, style)
+
+		if x0 == x1 && y0 == y1 {
+			return
+		}
+
+		e2 := 2 * e
+
+		if e2 >= dy {
+			e += dy
+			x0 += sx
+		}
+
+		if e2 <= dx {
+			e += dx
+			y0 += sy
+		}
+	}
+}
+
+// Draw draws the canvas to screen with the top left corner at x, y. Empty
+// cells are not drawn.
+func (c *BrailleCanvas) Draw(screen tcell.Screen, x, y int) {
+	for cy := 0; cy < c.height; cy++ {
+		for cx := 0; cx < c.width; cx++ {
+			i := cy*c.width + cx
+
+			if c.cells[i] == 0 {
+				continue
+			}
+
+			screen.SetContent(x+cx, y+cy, brailleBlank+c.cells[i], nil, c.styles[i])
+		}
+	}
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+
+	return v
+}
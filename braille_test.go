@@ -0,0 +1,49 @@
+package tplot_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/jeremija/tplot"
+	"github.com/jeremija/tplot/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBrailleCanvas(t *testing.T) {
+	c := tplot.NewBrailleCanvas(3, 2)
+	scr := test.NewScreen()
+
+	w, h := c.DotSize()
+
+	assert.Equal(t, 6, w)
+	assert.Equal(t, 8, h)
+
+	c.Set(0, 0)
+	c.Set(1, 3)
+	c.Set(3, 1)
+	c.Line(0, 7, 5, 7, tcell.StyleDefault)
+	c.Point(5, 0, tcell.StyleDefault)
+	c.Set(-1, 0)
+	c.Set(6, 0)
+
+	assert.True(t, c.IsSet(1, 3))
+	assert.False(t, c.IsSet(1, 2))
+
+	c.Unset(1, 3)
+	assert.False(t, c.IsSet(1, 3))
+
+	c.Draw(scr, 0, 0)
+
+	exp := `
+⠁⠐⠈
+⣀⣀⣀`
+
+	fmt.Println("== expected ==")
+	fmt.Println(exp)
+	fmt.Println("==  actual  ==")
+	fmt.Println(scr.Content())
+	fmt.Println("==============")
+
+	assert.Equal(t, exp, "\n"+scr.Content())
+}
@@ -0,0 +1,100 @@
+package tplot
+
+import "fmt"
+
+// DataSource provides the values drawn by Bars, Ticks and Lines. It allows
+// the renderers to read the values without copying them.
+type DataSource interface {
+	// Len returns the number of values.
+	Len() int
+	// At returns the value at index i, where 0 is the oldest value.
+	At(i int) Decimal
+}
+
+// DecimalSlice implements DataSource for a slice of decimals.
+type DecimalSlice []Decimal
+
+var _ DataSource = DecimalSlice{}
+
+// Len implements DataSource.
+func (d DecimalSlice) Len() int {
+	return len(d)
+}
+
+// At implements DataSource.
+func (d DecimalSlice) At(i int) Decimal {
+	return d[i]
+}
+
+// dataWindow is a DataSource with n values of src starting at start.
+type dataWindow struct {
+	src   DataSource
+	start int
+	n     int
+}
+
+func (d dataWindow) Len() int {
+	return d.n
+}
+
+func (d dataWindow) At(i int) Decimal {
+	checkIndex(i, d.n)
+
+	return d.src.At(d.start + i)
+}
+
+// checkIndex panics like an index expression of a slice when i is not an
+// index of a DataSource with l values.
+func checkIndex(i, l int) {
+	if i < 0 || i >= l {
+		panic(fmt.Sprintf("index out of range [%d] with length %d", i, l))
+	}
+}
+
+// window returns a DataSource with up to n values of src starting at start,
+// without copying the values.
+func window(src DataSource, start, n int) DataSource {
+	l := src.Len()
+
+	if start < 0 {
+		start = 0
+	}
+
+	if start > l {
+		start = l
+	}
+
+	if n > l-start {
+		n = l - start
+	}
+
+	if n < 0 {
+		n = 0
+	}
+
+	if s, ok := src.(DecimalSlice); ok {
+		return s[start : start+n]
+	}
+
+	return dataWindow{
+		src:   src,
+		start: start,
+		n:     n,
+	}
+}
+
+// decimals returns the values of src as a slice. The values are only copied
+// when src is not a DecimalSlice.
+func decimals(src DataSource) []Decimal {
+	if s, ok := src.(DecimalSlice); ok {
+		return s
+	}
+
+	ret := make([]Decimal, src.Len())
+
+	for i := range ret {
+		ret[i] = src.At(i)
+	}
+
+	return ret
+}
@@ -1,6 +1,7 @@
 package tplot
 
 import (
+	"fmt"
 	"math"
 	"strconv"
 )
@@ -14,6 +15,11 @@ type DecimalValue struct {
 	Valid bool
 }
 
+// Decimal is a decimal number. Implementations can also have a
+// StringFixed(places int32) string method, which returns the value rounded
+// to places digits after the decimal point, padded with trailing zeros.
+// Decimals without it are formatted using their float64 value, see the
+// StringFixed function.
 type Decimal interface {
 	IsZero() bool
 	Add(Decimal) Decimal
@@ -29,11 +35,79 @@ type Decimal interface {
 	IntPart() int64
 }
 
+// DecimalFactory creates Decimals. Implementations can also have a
+// NewFromString(string) (Decimal, error) method, which parses a decimal
+// number like -123.456, and a NewFromFloat64(float64) Decimal method, which
+// converts a float64 to Decimal. Factories without them parse and convert
+// the values using Zero and NewFromInt64, see the NewFromString and
+// NewFromFloat64 functions.
 type DecimalFactory interface {
 	Zero() Decimal
 	NewFromInt64(int64) Decimal
 }
 
+// StringFixed returns value rounded to places digits after the decimal point,
+// using the StringFixed method of value when it has one. Otherwise the value
+// is formatted using its float64 value.
+func StringFixed(value Decimal, places int32) string {
+	if places < 0 {
+		places = 0
+	}
+
+	if d, ok := value.(interface{ StringFixed(int32) string }); ok {
+		return d.StringFixed(places)
+	}
+
+	return strconv.FormatFloat(value.Float64(), 'f', int(places), 64)
+}
+
+// NewFromString parses s using the NewFromString method of factory when it
+// has one, otherwise s is parsed as a float64 and converted by
+// NewFromFloat64. It allows loaders to parse values with any DecimalFactory.
+func NewFromString(factory DecimalFactory, s string) (Decimal, error) {
+	if f, ok := factory.(interface {
+		NewFromString(string) (Decimal, error)
+	}); ok {
+		return f.NewFromString(s)
+	}
+
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid decimal: %q", s)
+	}
+
+	return NewFromFloat64(factory, v), nil
+}
+
+// fallbackFloatScale is the number of fractions of one used to convert the
+// fractional part of a float64 by NewFromFloat64.
+const fallbackFloatScale = 1e9
+
+// NewFromFloat64 converts v using the NewFromFloat64 method of factory when
+// it has one. Otherwise the integer part and the fractional part, rounded to
+// nine decimals, are converted separately. NaN and infinities are converted
+// to zero.
+func NewFromFloat64(factory DecimalFactory, v float64) Decimal {
+	if f, ok := factory.(interface{ NewFromFloat64(float64) Decimal }); ok {
+		return f.NewFromFloat64(v)
+	}
+
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return factory.Zero()
+	}
+
+	intPart, frac := math.Modf(v)
+
+	ret := factory.NewFromInt64(int64(intPart))
+
+	if n := int64(math.Round(frac * fallbackFloatScale)); n != 0 {
+		fraction := factory.NewFromInt64(n).Div(factory.NewFromInt64(fallbackFloatScale))
+		ret = ret.Add(fraction)
+	}
+
+	return ret
+}
+
 type FloatFactory struct{}
 
 func (f FloatFactory) Zero() Decimal {
@@ -44,41 +118,68 @@ func (f FloatFactory) NewFromInt64(i int64) Decimal {
 	return Float(i)
 }
 
+func (f FloatFactory) NewFromString(s string) (Decimal, error) {
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid decimal: %q", s)
+	}
+
+	return Float(v), nil
+}
+
+func (f FloatFactory) NewFromFloat64(v float64) Decimal {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return Float(0)
+	}
+
+	return Float(v)
+}
+
 // Float is an implemnetation of Decimal that uses float64.
 type Float float64
 
 var _ Decimal = Float(0)
 
+// toFloat converts other to Float. Decimals of other types are converted
+// using their float64 value.
+func toFloat(other Decimal) Float {
+	if f, ok := other.(Float); ok {
+		return f
+	}
+
+	return Float(other.Float64())
+}
+
 func (f Float) IsZero() bool {
 	return f == 0
 }
 
 func (f Float) Add(other Decimal) Decimal {
-	return Float(f + other.(Float))
+	return Float(f + toFloat(other))
 }
 
 func (f Float) Sub(other Decimal) Decimal {
-	return Float(f - other.(Float))
+	return Float(f - toFloat(other))
 }
 
 func (f Float) Mul(other Decimal) Decimal {
-	return Float(f * other.(Float))
+	return Float(f * toFloat(other))
 }
 
 func (f Float) Div(other Decimal) Decimal {
-	return Float(f / other.(Float))
+	return Float(f / toFloat(other))
 }
 
 func (f Float) Equal(other Decimal) bool {
-	return f == other.(Float)
+	return f == toFloat(other)
 }
 
 func (f Float) GreaterThan(other Decimal) bool {
-	return f > other.(Float)
+	return f > toFloat(other)
 }
 
 func (f Float) LessThan(other Decimal) bool {
-	return f < other.(Float)
+	return f < toFloat(other)
 }
 
 func (f Float) Round() Decimal {
@@ -88,6 +189,15 @@ func (f Float) Round() Decimal {
 func (f Float) String() string {
 	return strconv.FormatFloat(float64(f), 'f', -1, 64)
 }
+
+func (f Float) StringFixed(places int32) string {
+	if places < 0 {
+		places = 0
+	}
+
+	return strconv.FormatFloat(float64(f), 'f', int(places), 64)
+}
+
 func (f Float) Float64() float64 {
 	return float64(f)
 }
@@ -0,0 +1,376 @@
+package tplot
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// FixedFactory creates Fixed decimals with Scale digits after the decimal
+// point.
+type FixedFactory struct {
+	Scale int32
+}
+
+var _ DecimalFactory = FixedFactory{}
+
+// NewFixedFactory creates a new FixedFactory for the given scale.
+func NewFixedFactory(scale int32) FixedFactory {
+	if scale < 0 {
+		scale = 0
+	}
+
+	return FixedFactory{
+		Scale: scale,
+	}
+}
+
+func (f FixedFactory) Zero() Decimal {
+	return NewFixed(0, f.Scale)
+}
+
+func (f FixedFactory) NewFromInt64(i int64) Decimal {
+	return NewFixed(i, 0).rescale(f.Scale)
+}
+
+// NewFromString parses s and rounds it to the factory scale.
+func (f FixedFactory) NewFromString(s string) (Decimal, error) {
+	d, err := parseFixed(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.rescale(f.Scale), nil
+}
+
+// NewFromFloat64 converts v to Fixed rounded to the factory scale.
+func (f FixedFactory) NewFromFloat64(v float64) Decimal {
+	d, err := parseFixed(strconv.FormatFloat(v, 'f', -1, 64))
+	if err != nil {
+		// NaN or infinity.
+		return f.Zero()
+	}
+
+	return d.rescale(f.Scale)
+}
+
+// FixedDivisionScale is the minimum number of digits after the decimal point
+// of the results of Fixed.Div.
+const FixedDivisionScale = 16
+
+// Fixed is an exact implementation of Decimal that stores the value as an
+// arbitrary precision integer multiple of 10^-scale. Addition, subtraction
+// and comparison are exact. Results of multiplication are rounded half away
+// from zero to the larger scale of the operands, and results of division to
+// the larger scale of the operands, but at least FixedDivisionScale. The zero
+// value is a valid zero with scale 0.
+type Fixed struct {
+	value *big.Int
+	scale int32
+}
+
+var _ Decimal = Fixed{}
+
+// NewFixed creates a new Fixed decimal with the value of value * 10^-scale.
+func NewFixed(value int64, scale int32) Fixed {
+	if scale < 0 {
+		scale = 0
+	}
+
+	return Fixed{
+		value: big.NewInt(value),
+		scale: scale,
+	}
+}
+
+// parseFixed parses a decimal number like -123.456 or 1.5e-3. The scale is
+// the number of digits after the decimal point.
+func parseFixed(s string) (Fixed, error) {
+	invalid := fmt.Errorf("invalid decimal: %q", s)
+
+	str := s
+	exp := 0
+
+	if i := strings.IndexAny(str, "eE"); i >= 0 {
+		var err error
+
+		if exp, err = strconv.Atoi(str[i+1:]); err != nil {
+			return Fixed{}, invalid
+		}
+
+		str = str[:i]
+	}
+
+	sign := ""
+
+	if len(str) > 0 && (str[0] == '-' || str[0] == '+') {
+		sign, str = str[:1], str[1:]
+	}
+
+	intPart, fracPart := str, ""
+
+	if i := strings.IndexByte(str, '.'); i >= 0 {
+		intPart, fracPart = str[:i], str[i+1:]
+	}
+
+	if intPart == "" && fracPart == "" {
+		return Fixed{}, invalid
+	}
+
+	for _, r := range intPart + fracPart {
+		if r < '0' || r > '9' {
+			return Fixed{}, invalid
+		}
+	}
+
+	value, ok := new(big.Int).SetString(sign+intPart+fracPart, 10)
+	if !ok {
+		return Fixed{}, invalid
+	}
+
+	scale := len(fracPart) - exp
+
+	if scale < 0 {
+		value.Mul(value, pow10(int32(-scale)))
+		scale = 0
+	}
+
+	return Fixed{
+		value: value,
+		scale: int32(scale),
+	}, nil
+}
+
+// toFixed converts other to Fixed. Decimals of other types are converted
+// using their string representation, or their float64 value when the string
+// cannot be parsed.
+func toFixed(other Decimal) Fixed {
+	if f, ok := other.(Fixed); ok {
+		return f
+	}
+
+	if f, err := parseFixed(other.String()); err == nil {
+		return f
+	}
+
+	f, err := parseFixed(strconv.FormatFloat(other.Float64(), 'f', -1, 64))
+	if err != nil {
+		// NaN or infinity.
+		return Fixed{}
+	}
+
+	return f
+}
+
+// int returns the underlying integer, which is nil for the zero value.
+func (f Fixed) int() *big.Int {
+	if f.value == nil {
+		return new(big.Int)
+	}
+
+	return f.value
+}
+
+// Scale returns the number of digits after the decimal point.
+func (f Fixed) Scale() int32 {
+	return f.scale
+}
+
+// rescale returns f with the given scale, rounded half away from zero when
+// digits need to be removed.
+func (f Fixed) rescale(scale int32) Fixed {
+	switch {
+	case scale > f.scale:
+		return Fixed{
+			value: new(big.Int).Mul(f.int(), pow10(scale-f.scale)),
+			scale: scale,
+		}
+	case scale < f.scale:
+		return Fixed{
+			value: roundQuo(f.int(), pow10(f.scale-scale)),
+			scale: scale,
+		}
+	default:
+		return f
+	}
+}
+
+// align returns both operands with the same, larger scale.
+func (f Fixed) align(other Decimal) (Fixed, Fixed) {
+	o := toFixed(other)
+	scale := maxScale(f, o)
+
+	return f.rescale(scale), o.rescale(scale)
+}
+
+func (f Fixed) IsZero() bool {
+	return f.int().Sign() == 0
+}
+
+func (f Fixed) Add(other Decimal) Decimal {
+	a, b := f.align(other)
+
+	return Fixed{
+		value: new(big.Int).Add(a.int(), b.int()),
+		scale: a.scale,
+	}
+}
+
+func (f Fixed) Sub(other Decimal) Decimal {
+	a, b := f.align(other)
+
+	return Fixed{
+		value: new(big.Int).Sub(a.int(), b.int()),
+		scale: a.scale,
+	}
+}
+
+func (f Fixed) Mul(other Decimal) Decimal {
+	o := toFixed(other)
+
+	ret := Fixed{
+		value: new(big.Int).Mul(f.int(), o.int()),
+		scale: f.scale + o.scale,
+	}
+
+	return ret.rescale(maxScale(f, o))
+}
+
+// Div divides f by other, rounding the result half away from zero to the
+// larger scale of the operands, but at least FixedDivisionScale. It panics
+// when other is zero.
+func (f Fixed) Div(other Decimal) Decimal {
+	o := toFixed(other)
+
+	scale := maxScale(f, o)
+	if scale < FixedDivisionScale {
+		scale = FixedDivisionScale
+	}
+
+	// f / o * 10^scale = f.value * 10^(scale + o.scale - f.scale) / o.value
+	num := new(big.Int).Mul(f.int(), pow10(scale+o.scale-f.scale))
+
+	return Fixed{
+		value: roundQuo(num, o.int()),
+		scale: scale,
+	}
+}
+
+func (f Fixed) cmp(other Decimal) int {
+	a, b := f.align(other)
+
+	return a.int().Cmp(b.int())
+}
+
+func (f Fixed) Equal(other Decimal) bool {
+	return f.cmp(other) == 0
+}
+
+func (f Fixed) GreaterThan(other Decimal) bool {
+	return f.cmp(other) > 0
+}
+
+func (f Fixed) LessThan(other Decimal) bool {
+	return f.cmp(other) < 0
+}
+
+func (f Fixed) Float64() float64 {
+	v, _ := strconv.ParseFloat(f.String(), 64)
+
+	return v
+}
+
+// String returns the value without trailing zeros after the decimal point.
+func (f Fixed) String() string {
+	return f.format(true)
+}
+
+// StringFixed returns the value rounded half away from zero to places digits
+// after the decimal point.
+func (f Fixed) StringFixed(places int32) string {
+	if places < 0 {
+		places = 0
+	}
+
+	return f.rescale(places).format(false)
+}
+
+// format formats the value with all digits after the decimal point, unless
+// trim is set.
+func (f Fixed) format(trim bool) string {
+	v := f.int()
+
+	digits := new(big.Int).Abs(v).String()
+
+	if f.scale > 0 {
+		if pad := int(f.scale) + 1 - len(digits); pad > 0 {
+			digits = strings.Repeat("0", pad) + digits
+		}
+
+		i := len(digits) - int(f.scale)
+
+		frac := digits[i:]
+		digits = digits[:i]
+
+		if trim {
+			frac = strings.TrimRight(frac, "0")
+		}
+
+		if frac != "" {
+			digits += "." + frac
+		}
+	}
+
+	if v.Sign() < 0 {
+		return "-" + digits
+	}
+
+	return digits
+}
+
+// Round rounds the value half away from zero to an integer.
+func (f Fixed) Round() Decimal {
+	return f.rescale(0).rescale(f.scale)
+}
+
+// IntPart returns the integer part of the value, truncated towards zero.
+func (f Fixed) IntPart() int64 {
+	return new(big.Int).Quo(f.int(), pow10(f.scale)).Int64()
+}
+
+func maxScale(a, b Fixed) int32 {
+	if a.scale > b.scale {
+		return a.scale
+	}
+
+	return b.scale
+}
+
+// pow10 returns 10^n.
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// roundQuo returns a / b rounded half away from zero.
+func roundQuo(a, b *big.Int) *big.Int {
+	q, r := new(big.Int).QuoRem(a, b, new(big.Int))
+
+	if r.Sign() == 0 {
+		return q
+	}
+
+	// |2r| >= |b| means the remainder is at least half of the divisor.
+	r2 := new(big.Int).Abs(r)
+	r2.Lsh(r2, 1)
+
+	if r2.CmpAbs(b) >= 0 {
+		if a.Sign()*b.Sign() < 0 {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+
+	return q
+}
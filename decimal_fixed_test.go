@@ -0,0 +1,182 @@
+package tplot_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jeremija/tplot"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFixed(t *testing.T) {
+	factory := tplot.NewFixedFactory(2)
+
+	d := func(value int64, scale int32) tplot.Decimal {
+		return tplot.NewFixed(value, scale)
+	}
+
+	assert.Equal(t, "0", factory.Zero().String())
+	assert.Equal(t, "0", tplot.Fixed{}.String())
+	assert.True(t, tplot.Fixed{}.IsZero())
+	assert.Equal(t, "-12", factory.NewFromInt64(-12).String())
+
+	assert.Equal(t, "0.3", d(1, 1).Add(d(2, 1)).String())
+	assert.Equal(t, "1.00000003", d(1, 0).Add(d(3, 8)).String())
+	assert.Equal(t, "-0.05", d(5, 2).Sub(d(1, 1)).String())
+	assert.Equal(t, "0.02", d(15, 2).Mul(d(15, 2)).String())
+	assert.Equal(t, "0.3333333333333333", d(1, 2).Mul(factory.NewFromInt64(100)).Div(factory.NewFromInt64(3)).String())
+	assert.Equal(t, "0.6666666666666667", factory.NewFromInt64(2).Div(factory.NewFromInt64(3)).String())
+	assert.Equal(t, "-0.6666666666666667", factory.NewFromInt64(-2).Div(factory.NewFromInt64(3)).String())
+	assert.Equal(t, "0.00000000000000000003", d(10, 20).Div(factory.NewFromInt64(3)).String())
+	assert.Equal(t, "123456789.12345678", d(12345678912345678, 8).String())
+
+	assert.True(t, d(10, 1).Equal(d(1, 0)))
+	assert.True(t, d(11, 1).GreaterThan(d(1, 0)))
+	assert.True(t, d(-11, 1).LessThan(d(-1, 0)))
+
+	assert.Equal(t, "3", d(25, 1).Round().String())
+	assert.Equal(t, "-3", d(-25, 1).Round().String())
+	assert.Equal(t, "2", d(24, 1).Round().String())
+	assert.Equal(t, int64(2), d(29, 1).IntPart())
+	assert.Equal(t, int64(-2), d(-29, 1).IntPart())
+	assert.Equal(t, 1.25, d(125, 2).Float64())
+
+	assert.Panics(t, func() {
+		d(1, 0).Div(factory.Zero())
+	})
+}
+
+func TestFixed_divScale0(t *testing.T) {
+	factory := tplot.NewFixedFactory(0)
+
+	div := func(a, b int64) string {
+		return factory.NewFromInt64(a).Div(factory.NewFromInt64(b)).String()
+	}
+
+	assert.Equal(t, "0.3333333333333333", div(1, 3))
+	assert.Equal(t, "3.5", div(7, 2))
+	assert.Equal(t, "-3.5", div(-7, 2))
+	assert.Equal(t, "4", div(8, 2))
+	assert.Equal(t, "0.0000000000000001", div(1, 10000000000000000))
+	assert.Equal(t, "0", div(1, 100000000000000000))
+}
+
+func TestFixed_mixed(t *testing.T) {
+	assert.Equal(t, "1.75", tplot.NewFixed(125, 2).Add(tplot.Float(0.5)).String())
+	assert.True(t, tplot.NewFixed(5, 1).Equal(tplot.Float(0.5)))
+	assert.Equal(t, tplot.Float(1.75), tplot.Float(0.5).Add(tplot.NewFixed(125, 2)))
+}
+
+func TestFixed_zeroValue(t *testing.T) {
+	var zero tplot.Fixed
+
+	assert.Equal(t, "1", zero.Add(tplot.NewFixed(1, 0)).String())
+	assert.Equal(t, "0", zero.Add(zero).String())
+	assert.Equal(t, "-1.5", zero.Sub(tplot.NewFixed(15, 1)).String())
+	assert.Equal(t, "0", zero.Mul(tplot.NewFixed(2, 0)).String())
+	assert.Equal(t, "0", zero.Div(tplot.NewFixed(2, 0)).String())
+	assert.True(t, zero.Equal(tplot.NewFixed(0, 3)))
+	assert.True(t, zero.LessThan(tplot.NewFixed(1, 0)))
+	assert.True(t, zero.GreaterThan(tplot.NewFixed(-1, 0)))
+
+	// NaN and infinity cannot be represented, so they are converted to zero.
+	for _, f := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		other := tplot.Float(f)
+
+		assert.Equal(t, "1", tplot.NewFixed(1, 0).Add(other).String(), f)
+		assert.Equal(t, "1", tplot.NewFixed(1, 0).Sub(other).String(), f)
+		assert.Equal(t, "0", tplot.NewFixed(1, 0).Mul(other).String(), f)
+		assert.True(t, zero.Equal(other), f)
+		assert.False(t, zero.LessThan(other), f)
+	}
+}
+
+func TestFixedFactory(t *testing.T) {
+	factory := tplot.NewFixedFactory(4)
+
+	parse := func(s string) string {
+		d, err := factory.NewFromString(s)
+		assert.NoError(t, err)
+
+		return d.String()
+	}
+
+	assert.Equal(t, "123.4568", parse("123.456789"))
+	assert.Equal(t, "-0.5", parse("-.5"))
+	assert.Equal(t, "1500", parse("1.5e3"))
+	assert.Equal(t, "0.0015", parse("1.5E-3"))
+	assert.Equal(t, "7", parse("+7"))
+
+	for _, s := range []string{"", "-", ".", "1.2.3", "abc", "1e", "0x10"} {
+		_, err := factory.NewFromString(s)
+		assert.Error(t, err, s)
+	}
+
+	assert.Equal(t, "0.1", factory.NewFromFloat64(0.1).String())
+	assert.Equal(t, "-2.3457", factory.NewFromFloat64(-2.34567).String())
+	assert.Equal(t, "0", factory.NewFromFloat64(math.NaN()).String())
+
+	assert.Equal(t, "1.50", tplot.NewFixed(15, 1).StringFixed(2))
+	assert.Equal(t, "1.13", tplot.NewFixed(1125, 3).StringFixed(2))
+	assert.Equal(t, "-1.13", tplot.NewFixed(-1125, 3).StringFixed(2))
+	assert.Equal(t, "0.00", tplot.Fixed{}.StringFixed(2))
+	assert.Equal(t, "2", tplot.NewFixed(15, 1).StringFixed(0))
+}
+
+func TestFloatFactory(t *testing.T) {
+	var factory tplot.FloatFactory
+
+	d, err := factory.NewFromString("123.456")
+	assert.NoError(t, err)
+	assert.Equal(t, tplot.Float(123.456), d)
+
+	_, err = factory.NewFromString("abc")
+	assert.Error(t, err)
+
+	assert.Equal(t, tplot.Float(0), factory.NewFromFloat64(math.Inf(1)))
+	assert.Equal(t, "1.50", tplot.Float(1.5).StringFixed(2))
+}
+
+// plainDecimal and plainFactory only have the methods of the Decimal and
+// DecimalFactory interfaces.
+type plainDecimal struct{ tplot.Decimal }
+
+type plainFactory struct{ tplot.DecimalFactory }
+
+func TestDecimal_withoutOptionalMethods(t *testing.T) {
+	assert.Equal(t, "1.50", tplot.DefaultFormatter(plainDecimal{tplot.Float(1.5)}, 2))
+	assert.Equal(t, "-2", tplot.DefaultFormatter(plainDecimal{tplot.Float(-2)}, -1))
+
+	factory := plainFactory{tplot.FloatFactory{}}
+
+	scale := tplot.NewScaleLog(factory)
+	scale.SetRange(tplot.NewRange(factory).Feed(tplot.Float(1)).Feed(tplot.Float(1000)))
+	scale.SetSize(31)
+
+	assert.Equal(t, []tplot.Decimal{
+		tplot.Float(1),
+		tplot.Float(10),
+		tplot.Float(100),
+		tplot.Float(1000),
+	}, scale.Ticks(2))
+
+	// The fraction is rounded to nine decimals.
+	assert.InDelta(t, 31.622776602, scale.Reverse(15).Float64(), 1e-12)
+
+	assert.Equal(t, "1.13", tplot.StringFixed(plainDecimal{tplot.Float(1.126)}, 2))
+	assert.Equal(t, "1.13", tplot.StringFixed(tplot.NewFixed(1125, 3), 2))
+
+	d, err := tplot.NewFromString(factory, "-12.5")
+	assert.NoError(t, err)
+	assert.Equal(t, tplot.Float(-12.5), d)
+
+	_, err = tplot.NewFromString(factory, "abc")
+	assert.Error(t, err)
+
+	fixed, err := tplot.NewFromString(tplot.NewFixedFactory(2), "1.005")
+	assert.NoError(t, err)
+	assert.Equal(t, "1.01", fixed.String())
+
+	assert.Equal(t, tplot.Float(0), tplot.NewFromFloat64(factory, math.NaN()))
+	assert.Equal(t, tplot.Float(2.25), tplot.NewFromFloat64(factory, 2.25))
+}
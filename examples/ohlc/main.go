@@ -6,7 +6,9 @@ import (
 	"os"
 	"time"
 
+	"github.com/gdamore/tcell/v2"
 	"github.com/jeremija/tplot"
+	"github.com/jeremija/tplot/indicators"
 	"github.com/rivo/tview"
 )
 
@@ -26,7 +28,7 @@ func main() {
 		logger = log
 	}
 
-	var factory tplot.FloatFactory
+	var factory tplot.DecimalFactory = tplot.FloatFactory{}
 
 	ohlcPanel := tplot.NewOHLCChart(factory)
 	ohlcPanel.SetLogger(logger)
@@ -49,12 +51,12 @@ func main() {
 	f.Close()
 
 	mustDec := func(n json.Number) tplot.Decimal {
-		f64, err := n.Float64()
+		dec, err := tplot.NewFromString(factory, n.String())
 		if err != nil {
 			panic(err)
 		}
 
-		return tplot.Float(f64)
+		return dec
 	}
 
 	mustInt64 := func(n json.Number) int64 {
@@ -87,6 +89,42 @@ func main() {
 	}
 
 	ohlcPanel.SetItems(items)
+	ohlcPanel.SetTimeframes([]time.Duration{0, 7 * 24 * time.Hour, 28 * 24 * time.Hour})
+
+	sma := tplot.NewOverlay("SMA(20)", indicators.NewSMA(factory, 20))
+	sma.SetStyle(tcell.StyleDefault.Foreground(tcell.ColorYellow))
+	ohlcPanel.AddOverlay(sma)
+
+	bollinger := indicators.NewBollinger(factory, 20, factory.NewFromInt64(2))
+
+	for _, band := range []tplot.Indicator{bollinger.Upper(), bollinger.Lower()} {
+		overlay := tplot.NewOverlay("BB(20, 2)", band)
+		overlay.SetStyle(tcell.StyleDefault.Foreground(tcell.ColorPurple))
+		ohlcPanel.AddOverlay(overlay)
+	}
+
+	rsi := tplot.NewOHLCPane(factory, "RSI(14)")
+	rsi.AddOverlay(tplot.NewOverlay("RSI(14)", indicators.NewRSI(factory, 14)))
+	ohlcPanel.AddPane(rsi)
+
+	macd := indicators.NewMACD(factory, 12, 26, 9)
+	macdPane := tplot.NewOHLCPane(factory, "MACD(12, 26, 9)")
+
+	macdHistogram := tplot.NewOverlay("Histogram", macd.Histogram())
+	macdHistogram.SetType(tplot.OverlayHistogram)
+	macdHistogram.SetStyle(tcell.StyleDefault.Foreground(tcell.ColorGray))
+	macdPane.AddOverlay(macdHistogram)
+
+	macdLine := tplot.NewOverlay("MACD", macd.Line())
+	macdLine.SetStyle(tcell.StyleDefault.Foreground(tcell.ColorBlue))
+	macdPane.AddOverlay(macdLine)
+
+	macdSignal := tplot.NewOverlay("Signal", macd.Signal())
+	macdSignal.SetStyle(tcell.StyleDefault.Foreground(tcell.ColorOrange))
+	macdPane.AddOverlay(macdSignal)
+
+	ohlcPanel.AddPane(macdPane)
+
 	ohlcPanel.SetBorder(true)
 
 	layout := tview.NewFlex().
@@ -2,6 +2,7 @@ package main
 
 import (
 	"math/rand"
+	"time"
 
 	"github.com/gdamore/tcell/v2"
 	"github.com/jeremija/tplot"
@@ -16,17 +17,27 @@ func main() {
 	r := rand.New(rand.NewSource(100))
 
 	size := 100
-	data := make([]tplot.Decimal, size)
+	data := tplot.NewRingBuffer(size)
 
 	for i := 0; i < size; i++ {
-		data[i] = tplot.Float(r.Float64())
+		data.Push(tplot.Float(r.Float64()))
 	}
 
 	ticks.SetSpacing(2)
 	ticks.SetStyle(tcell.StyleDefault.Foreground(tcell.ColorBlue))
-	ticks.SetData(data)
+	ticks.SetSource(data)
 
-	if err := tview.NewApplication().SetRoot(ticks, true).Run(); err != nil {
+	app := tview.NewApplication().SetRoot(ticks, true)
+
+	go func() {
+		for range time.Tick(time.Second) {
+			app.QueueUpdateDraw(func() {
+				data.Push(tplot.Float(r.Float64()))
+			})
+		}
+	}()
+
+	if err := app.Run(); err != nil {
 		panic(err)
 	}
 }
@@ -3,6 +3,7 @@ package main
 import (
 	"time"
 
+	"github.com/gdamore/tcell/v2"
 	"github.com/jeremija/tplot"
 	"github.com/rivo/tview"
 )
@@ -11,6 +12,8 @@ func main() {
 	size := 1001
 	ohlcs := make([]tplot.OHLC, size)
 	tickData := make([]tplot.Decimal, size)
+	opens := make([]tplot.Decimal, size)
+	heatmapData := make([][]tplot.Decimal, size)
 	ts := time.Now().Truncate(time.Minute)
 
 	var decFactory tplot.FloatFactory
@@ -29,6 +32,13 @@ func main() {
 		}
 
 		tickData[i] = ohlcs[i].V
+		opens[i] = ohlcs[i].O.Mul(tplot.Float(100))
+
+		heatmapData[i] = make([]tplot.Decimal, 20)
+
+		for j := range heatmapData[i] {
+			heatmapData[i][j] = tplot.Float((i * (j + 1)) % 97)
+		}
 	}
 
 	app := tview.NewApplication()
@@ -46,6 +56,116 @@ func main() {
 			c.SetPrimitive(bars)
 			app.SetFocus(bars)
 		})
+		list.AddItem("Horizontal Bar", "Horizontal Bar Chart", 'r', func() {
+			bars := tplot.NewHorizontalBars(decFactory)
+			bars.SetData(tickData[:10])
+			bars.SetLabels([]string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j"})
+
+			axisBox := tplot.NewAxisBox(tplot.NewAxis(decFactory), bars)
+			axisBox.SetPosition(tplot.Bottom)
+
+			c.SetPrimitive(axisBox)
+			app.SetFocus(axisBox)
+		})
+		list.AddItem("Multi Bar", "Stacked Bar Chart", 'm', func() {
+			bars := tplot.NewMultiBars(decFactory)
+			bars.SetSpacing(2)
+			bars.SetSeries([]tplot.Series{
+				{
+					Name:  "Open",
+					Style: tcell.StyleDefault.Foreground(tcell.ColorGreen),
+					Data:  opens,
+				},
+				{
+					Name:  "Volume",
+					Style: tcell.StyleDefault.Foreground(tcell.ColorBlue),
+					Data:  tickData,
+				},
+			})
+
+			legend := tplot.NewLegend()
+			legend.SetItems(tplot.SeriesLegendItems(bars.Series()))
+
+			axisBox := tplot.NewAxisBox(tplot.NewAxis(decFactory), bars)
+			axisBox.SetLegend(legend, tplot.TopLeft)
+
+			c.SetPrimitive(axisBox)
+			app.SetFocus(axisBox)
+		})
+		list.AddItem("Histogram", "Histogram", 'h', func() {
+			histogram := tplot.NewHistogram(decFactory)
+			histogram.SetSamples(opens)
+			histogram.SetBinCount(20)
+			histogram.SetPercentiles([]float64{50, 90, 99})
+
+			axisBox := tplot.NewAxisBox(tplot.NewAxis(decFactory), histogram)
+
+			c.SetPrimitive(axisBox)
+			app.SetFocus(axisBox)
+		})
+		list.AddItem("Heatmap", "Heatmap", 'e', func() {
+			heatmap := tplot.NewHeatmap(decFactory)
+			heatmap.SetData(heatmapData)
+			heatmap.SetHalfBlocks(true)
+
+			axisBox := tplot.NewAxisBox(tplot.NewAxis(decFactory), heatmap)
+
+			c.SetPrimitive(axisBox)
+			app.SetFocus(axisBox)
+		})
+		list.AddItem("Sparkline", "Sparklines in a Table", 's', func() {
+			table := tview.NewTable()
+
+			for i, name := range []string{"Open", "Volume"} {
+				data := opens
+				if i == 1 {
+					data = tickData
+				}
+
+				table.SetCell(i, 0, tview.NewTableCell(name))
+				table.SetCell(i, 1, tview.NewTableCell(tplot.SparklineText(decFactory, data, 40)))
+			}
+
+			c.SetPrimitive(table)
+			app.SetFocus(table)
+		})
+		list.AddItem("Scatter", "Scatter Plot", 'p', func() {
+			points := make([]tplot.Point, size)
+
+			for i := range points {
+				points[i] = tplot.Point{X: tickData[i], Y: opens[(i*7)%size]}
+			}
+
+			scatter := tplot.NewScatter(decFactory)
+			scatter.SetSeries([]tplot.ScatterSeries{{
+				Name:   "Volume vs Open",
+				Style:  tcell.StyleDefault.Foreground(tcell.ColorYellow),
+				Points: points,
+			}})
+
+			c.SetPrimitive(scatter)
+			app.SetFocus(scatter)
+		})
+		list.AddItem("Area", "Stacked Area Chart", 'a', func() {
+			area := tplot.NewArea(decFactory)
+			area.SetSeries([]tplot.Series{
+				{
+					Name:  "Open",
+					Style: tcell.StyleDefault.Foreground(tcell.ColorGreen),
+					Data:  opens,
+				},
+				{
+					Name:  "Volume",
+					Style: tcell.StyleDefault.Foreground(tcell.ColorBlue),
+					Data:  tickData,
+				},
+			})
+
+			axisBox := tplot.NewAxisBox(tplot.NewAxis(decFactory), area)
+
+			c.SetPrimitive(axisBox)
+			app.SetFocus(axisBox)
+		})
 		list.AddItem("Tick", "Tick Chart", 't', func() {
 			ticks := tplot.NewTicks(decFactory)
 			ticks.SetData(tickData)
@@ -54,6 +174,24 @@ func main() {
 			c.SetPrimitive(ticks)
 			app.SetFocus(ticks)
 		})
+		list.AddItem("Line", "Line Chart", 'l', func() {
+			lines := tplot.NewLines(decFactory)
+			lines.SetData(tickData)
+			lines.SetSpacing(2)
+			lines.SetHLines([]tplot.HLine{{
+				Value: tickData[size/2],
+				Label: "mid",
+				Style: tcell.StyleDefault.Foreground(tcell.ColorRed),
+			}})
+			lines.SetVMarkers([]tplot.VMarker{{
+				Index: size - 10,
+				Label: "deploy",
+				Style: tcell.StyleDefault.Foreground(tcell.ColorYellow),
+			}})
+
+			c.SetPrimitive(lines)
+			app.SetFocus(lines)
+		})
 		list.AddItem("OHLC", "OHLC Candles", 'o', func() {
 			candles := tplot.NewOHLCCandles(decFactory)
 			candles.SetData(ohlcs)
@@ -0,0 +1,145 @@
+package tplot
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Formatter formats a value for display, for example as an Axis label.
+// Precision is the number of decimals needed to tell the neighbouring values
+// apart, which formatters may ignore.
+type Formatter func(value Decimal, precision int) string
+
+// DefaultFormatter formats the value with precision decimals.
+func DefaultFormatter(value Decimal, precision int) string {
+	return StringFixed(value, int32(precision))
+}
+
+// FixedFormatter returns a Formatter that always formats the value with the
+// given number of decimals.
+func FixedFormatter(decimals int) Formatter {
+	return func(value Decimal, precision int) string {
+		return StringFixed(value, int32(decimals))
+	}
+}
+
+// siSuffixes contains the suffixes used by SIFormatter, from the largest.
+var siSuffixes = []struct {
+	value  float64
+	suffix string
+}{
+	{1e12, "T"},
+	{1e9, "B"},
+	{1e6, "M"},
+	{1e3, "K"},
+}
+
+// SIFormatter returns a Formatter that abbreviates large values with the K,
+// M, B and T suffixes, e.g. 1234567 is formatted as 1.23M with two decimals.
+// Trailing zeros are removed.
+func SIFormatter(decimals int) Formatter {
+	return func(value Decimal, precision int) string {
+		f := value.Float64()
+
+		str := trimZeros(StringFixed(value, int32(decimals)))
+		suffix := ""
+
+		// Start from the smallest suffix and move to the next one while the
+		// rounded value reaches a thousand, so that 999999 is formatted as
+		// 1M instead of 1000K.
+		for i := len(siSuffixes) - 1; i >= 0 && atLeast(str, 1000); i-- {
+			str = trimZeros(strconv.FormatFloat(f/siSuffixes[i].value, 'f', decimals, 64))
+			suffix = siSuffixes[i].suffix
+		}
+
+		return str + suffix
+	}
+}
+
+// PercentFormatter returns a Formatter for ratios, e.g. 0.25 is formatted as
+// 25% with zero decimals.
+func PercentFormatter(decimals int) Formatter {
+	return func(value Decimal, precision int) string {
+		return strconv.FormatFloat(value.Float64()*100, 'f', decimals, 64) + "%"
+	}
+}
+
+// CurrencyFormatter returns a Formatter that prefixes the value with symbol,
+// e.g. -1.5 is formatted as -$1.50 with the $ symbol and two decimals.
+func CurrencyFormatter(symbol string, decimals int) Formatter {
+	return func(value Decimal, precision int) string {
+		str := StringFixed(value, int32(decimals))
+
+		if strings.HasPrefix(str, "-") {
+			return "-" + symbol + str[1:]
+		}
+
+		return symbol + str
+	}
+}
+
+// durationUnits contains the units used by DurationFormatter, from the
+// largest.
+var durationUnits = []struct {
+	value  time.Duration
+	suffix string
+}{
+	{time.Hour, "h"},
+	{time.Minute, "m"},
+	{time.Second, "s"},
+	{time.Millisecond, "ms"},
+	{time.Microsecond, "µs"},
+	{time.Nanosecond, "ns"},
+}
+
+// DurationFormatter returns a Formatter for values that are durations
+// measured in unit, for example latencies in milliseconds. The value is
+// formatted with at most decimals decimals in the largest unit that keeps it
+// at least one, e.g. 1500 with the time.Millisecond unit is formatted as 1.5s.
+// Trailing zeros are removed.
+func DurationFormatter(unit time.Duration, decimals int) Formatter {
+	return func(value Decimal, precision int) string {
+		ns := value.Float64() * float64(unit)
+
+		i := 0
+		for i < len(durationUnits)-1 && math.Abs(ns) < float64(durationUnits[i].value) {
+			i++
+		}
+
+		format := func(i int) string {
+			return trimZeros(strconv.FormatFloat(ns/float64(durationUnits[i].value), 'f', decimals, 64))
+		}
+
+		str := format(i)
+
+		// Move to the larger unit when the rounded value reaches it, so that
+		// 59.999s is formatted as 1m instead of 60s.
+		for i > 0 && atLeast(str, float64(durationUnits[i-1].value/durationUnits[i].value)) {
+			i--
+			str = format(i)
+		}
+
+		return str + durationUnits[i].suffix
+	}
+}
+
+// atLeast returns true when the absolute value of the formatted number str is
+// at least limit.
+func atLeast(str string, limit float64) bool {
+	f, err := strconv.ParseFloat(str, 64)
+
+	return err == nil && math.Abs(f) >= limit
+}
+
+// trimZeros removes the trailing zeros after the decimal point.
+func trimZeros(str string) string {
+	if !strings.Contains(str, ".") {
+		return str
+	}
+
+	str = strings.TrimRight(str, "0")
+
+	return strings.TrimSuffix(str, ".")
+}
@@ -0,0 +1,83 @@
+package tplot_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jeremija/tplot"
+	"github.com/jeremija/tplot/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatters(t *testing.T) {
+	f := func(v float64) tplot.Decimal {
+		return tplot.Float(v)
+	}
+
+	assert.Equal(t, "1.50", tplot.DefaultFormatter(f(1.5), 2))
+	assert.Equal(t, "1.500", tplot.FixedFormatter(3)(f(1.5), 0))
+
+	si := tplot.SIFormatter(2)
+	assert.Equal(t, "500", si(f(500), 2))
+	assert.Equal(t, "0.25", si(f(0.25), 2))
+	assert.Equal(t, "1.5K", si(f(1500), 2))
+	assert.Equal(t, "123.46M", si(f(123456789), 2))
+	assert.Equal(t, "-2B", si(f(-2e9), 2))
+	assert.Equal(t, "3T", si(f(3e12), 2))
+	assert.Equal(t, "1K", si(f(999.999), 2))
+	assert.Equal(t, "1M", si(f(999999), 2))
+	assert.Equal(t, "-1M", si(f(-999999), 2))
+	assert.Equal(t, "999.9K", tplot.SIFormatter(1)(f(999900), 2))
+	assert.Equal(t, "1000T", si(f(999.999e12), 2))
+
+	assert.Equal(t, "25%", tplot.PercentFormatter(0)(f(0.25), 2))
+	assert.Equal(t, "-1.5%", tplot.PercentFormatter(1)(f(-0.015), 2))
+
+	assert.Equal(t, "$1.50", tplot.CurrencyFormatter("$", 2)(f(1.5), 0))
+	assert.Equal(t, "-€3", tplot.CurrencyFormatter("€", 0)(f(-3), 2))
+
+	ms := tplot.DurationFormatter(time.Millisecond, 2)
+	assert.Equal(t, "250ms", ms(f(250), 0))
+	assert.Equal(t, "1.5s", ms(f(1500), 0))
+	assert.Equal(t, "1.23s", ms(f(1234), 0))
+	assert.Equal(t, "2m", ms(f(120000), 0))
+	assert.Equal(t, "750µs", ms(f(0.75), 0))
+	assert.Equal(t, "0ns", ms(f(0), 0))
+	assert.Equal(t, "1s", ms(f(999.999), 0))
+	assert.Equal(t, "1m", ms(f(59999), 0))
+	assert.Equal(t, "-1h", ms(f(-3599999), 0))
+
+	s := tplot.DurationFormatter(time.Second, 0)
+	assert.Equal(t, "2s", s(f(1.5), 2))
+	assert.Equal(t, "59s", s(f(59.4), 2))
+	assert.Equal(t, "1m", s(f(59.6), 2))
+	assert.Equal(t, "2h", s(f(5400), 2))
+}
+
+func TestAxis_formatter(t *testing.T) {
+	var factory tplot.FloatFactory
+
+	scale := tplot.NewScaleLinear(factory)
+	scale.SetRange(tplot.Range{
+		Min: tplot.Float(0),
+		Max: tplot.Float(2000),
+	})
+	scale.SetSize(5)
+
+	a := tplot.NewAxis(factory)
+	a.SetScale(scale)
+	a.SetFormatter(tplot.DurationFormatter(time.Millisecond, 2))
+	a.SetRect(0, 0, a.CalcWidth(), 5)
+
+	scr := test.NewScreen()
+	a.Draw(scr)
+
+	exp := `
+  2s
+
+  1s
+
+ 0ns`
+
+	assert.Equal(t, exp, "\n"+scr.Content())
+}
@@ -0,0 +1,83 @@
+package tplot
+
+import (
+	"math"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// trueColors is the number of colors of a true color screen.
+const trueColors = 1 << 24
+
+// Gradient maps the numbers from 0 to 1 to colors by interpolating between
+// evenly spaced color stops, from the color of 0 to the color of 1.
+type Gradient []tcell.Color
+
+// DefaultGradient goes from dark blue through teal and yellow to red.
+var DefaultGradient = Gradient{
+	tcell.NewRGBColor(0x1a, 0x1a, 0x40),
+	tcell.NewRGBColor(0x20, 0x60, 0xa0),
+	tcell.NewRGBColor(0x20, 0xb0, 0xa0),
+	tcell.NewRGBColor(0xf0, 0xe0, 0x40),
+	tcell.NewRGBColor(0xe0, 0x30, 0x20),
+}
+
+// Color returns the true color at t, which is clamped between 0 and 1.
+func (g Gradient) Color(t float64) tcell.Color {
+	switch len(g) {
+	case 0:
+		return tcell.ColorDefault
+	case 1:
+		return g[0]
+	}
+
+	if !(t > 0) {
+		t = 0
+	}
+
+	if t > 1 {
+		t = 1
+	}
+
+	pos := t * float64(len(g)-1)
+	i := int(math.Floor(pos))
+
+	if i >= len(g)-1 {
+		return g[len(g)-1].TrueColor()
+	}
+
+	frac := pos - float64(i)
+
+	r1, g1, b1 := g[i].TrueColor().RGB()
+	r2, g2, b2 := g[i+1].TrueColor().RGB()
+
+	lerp := func(a, b int32) int32 {
+		return a + int32(math.Round(float64(b-a)*frac))
+	}
+
+	return tcell.NewRGBColor(lerp(r1, r2), lerp(g1, g2), lerp(b1, b2))
+}
+
+// ScreenColor returns the color at t for a screen that supports the given
+// number of colors, see tcell.Screen.Colors. The closest color of the 256 or
+// 16 color palette is used when the screen does not support true color. This
+// is an expensive operation, so the results should be cached.
+func (g Gradient) ScreenColor(t float64, colors int) tcell.Color {
+	color := g.Color(t)
+
+	if colors >= trueColors || colors <= 0 {
+		return color
+	}
+
+	if colors > 256 {
+		colors = 256
+	}
+
+	palette := make([]tcell.Color, colors)
+
+	for i := range palette {
+		palette[i] = tcell.PaletteColor(i)
+	}
+
+	return tcell.FindColor(color, palette)
+}
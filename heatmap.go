@@ -0,0 +1,300 @@
+package tplot
+
+import (
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// heatmapColorSteps is the number of distinct colors of a Heatmap.
+const heatmapColorSteps = 256
+
+// Heatmap draws a matrix of values as colored cells, for example the counts
+// of latency buckets over time. Each item of the data is a column, and each
+// value of a column is a row, from the bottom. The columns are right-aligned
+// and the last columns are kept when there are more columns than fit on the
+// screen. The rows are stretched or shrunk to the height of the heatmap.
+//
+// The rows are placed with the scale, which also allows the Heatmap to be
+// used with an AxisBox. By default, the edges of the rows are their indexes,
+// see SetRowEdges, and each row takes the same number of places.
+type Heatmap struct {
+	*tview.Box
+
+	factory    DecimalFactory
+	data       [][]Decimal
+	scale      Scale
+	rowEdges   []Decimal
+	valueRange Range
+	gradient   Gradient
+	halfBlocks bool
+}
+
+// NewHeatmap creates a new instance of Heatmap.
+func NewHeatmap(factory DecimalFactory) *Heatmap {
+	return &Heatmap{
+		Box:      tview.NewBox(),
+		factory:  factory,
+		scale:    &binScale{factory: factory},
+		gradient: DefaultGradient,
+	}
+}
+
+// SetData sets the columns of the heatmap. A nil value is not drawn.
+func (h *Heatmap) SetData(data [][]Decimal) {
+	h.data = data
+	h.updateScale()
+}
+
+// Data returns the columns of the heatmap.
+func (h *Heatmap) Data() [][]Decimal {
+	return h.data
+}
+
+// SetRowEdges sets the edges of the rows, for example the bounds of the
+// latency buckets. There is one more edge than rows. The edges are the row
+// indexes when edges is nil or does not match the number of rows.
+func (h *Heatmap) SetRowEdges(edges []Decimal) {
+	h.rowEdges = edges
+	h.updateScale()
+}
+
+// RowEdges returns the edges of the rows.
+func (h *Heatmap) RowEdges() []Decimal {
+	return h.rowEdges
+}
+
+// SetValueRange sets the range of the values mapped to the gradient. The
+// range of the visible values is used when rng is not set.
+func (h *Heatmap) SetValueRange(rng Range) {
+	h.valueRange = rng
+}
+
+// ValueRange returns the range of the values mapped to the gradient.
+func (h *Heatmap) ValueRange() Range {
+	return h.valueRange
+}
+
+// SetGradient sets the gradient of the values.
+func (h *Heatmap) SetGradient(gradient Gradient) {
+	h.gradient = gradient
+}
+
+// Gradient returns the gradient of the values.
+func (h *Heatmap) Gradient() Gradient {
+	return h.gradient
+}
+
+// SetHalfBlocks enables drawing two rows per cell with half blocks, which
+// doubles the vertical resolution.
+func (h *Heatmap) SetHalfBlocks(halfBlocks bool) {
+	h.halfBlocks = halfBlocks
+}
+
+// HalfBlocks returns true when two rows are drawn per cell.
+func (h *Heatmap) HalfBlocks() bool {
+	return h.halfBlocks
+}
+
+// SetScale sets the scale of the rows. The range of the scale is set from the
+// first to the last edge, and each row is drawn from the place of its lower
+// edge, e.g. a ScaleLinear gives each row a height proportional to the
+// difference of its edges.
+func (h *Heatmap) SetScale(scale Scale) {
+	h.scale = scale
+	h.updateScale()
+}
+
+// Scale returns the scale of the rows.
+func (h *Heatmap) Scale() Scale {
+	return h.scale
+}
+
+// numRows returns the number of values of the longest column.
+func (h *Heatmap) numRows() int {
+	n := 0
+
+	for _, column := range h.data {
+		if len(column) > n {
+			n = len(column)
+		}
+	}
+
+	return n
+}
+
+// edges returns the edges of n rows.
+func (h *Heatmap) edges(n int) []Decimal {
+	if len(h.rowEdges) == n+1 {
+		return h.rowEdges
+	}
+
+	edges := make([]Decimal, n+1)
+
+	for i := range edges {
+		edges[i] = h.factory.NewFromInt64(int64(i))
+	}
+
+	return edges
+}
+
+// updateScale sets the row edges to the scale, so that the axis can be
+// drawn before the heatmap.
+func (h *Heatmap) updateScale() {
+	n := h.numRows()
+	edges := h.edges(n)
+
+	if scale, ok := h.scale.(*binScale); ok {
+		scale.edges = edges
+	} else {
+		h.scale.SetRange(NewRange(h.factory).Feed(edges[0]).Feed(edges[n]))
+	}
+}
+
+// rowIndexes returns the index of the row drawn at each of the places, or -1.
+// The rows are placed by a copy of the scale with the size of places. The
+// lowest row wins when several rows start at the same place.
+func (h *Heatmap) rowIndexes(edges []Decimal, places int) []int {
+	scale := h.scale.Copy()
+	scale.SetSize(places)
+
+	ret := make([]int, places)
+	for p := range ret {
+		ret[p] = -1
+	}
+
+	n := len(edges) - 1
+
+	for i := 0; i < n; i++ {
+		start, end := scale.Value(edges[i]), scale.Value(edges[i+1])
+
+		// The scales place the last edge in the last place, which belongs
+		// to the last row.
+		if i == n-1 {
+			end = places
+		}
+
+		if start < 0 {
+			start = 0
+		}
+
+		for p := start; p < end && p < places; p++ {
+			if ret[p] < 0 {
+				ret[p] = i
+			}
+		}
+	}
+
+	return ret
+}
+
+// calcRange returns the range of the values of data.
+func (h *Heatmap) calcRange(data [][]Decimal) Range {
+	rng := NewRange(h.factory)
+
+	for _, column := range data {
+		for _, value := range column {
+			if value != nil {
+				rng = rng.Feed(value)
+			}
+		}
+	}
+
+	return rng
+}
+
+// Draw implements tview.Primitive.
+func (h *Heatmap) Draw(screen tcell.Screen) {
+	h.DrawForSubclass(screen, h)
+
+	x, y, w, height := h.GetInnerRect()
+
+	h.updateScale()
+
+	n := h.numRows()
+	edges := h.edges(n)
+
+	if w == 0 || height == 0 || n == 0 {
+		return
+	}
+
+	data := h.data
+	if l := len(data); l > w {
+		data = data[l-w:]
+	}
+
+	rng := h.valueRange
+	if !rng.IsSet() {
+		rng = h.calcRange(data)
+	}
+
+	places := height
+	if h.halfBlocks {
+		places *= 2
+	}
+
+	rows := h.rowIndexes(edges, places)
+
+	colors := screen.Colors()
+	cache := make(map[int]tcell.Color)
+
+	min, max := rng.Min.Float64(), rng.Max.Float64()
+
+	// color returns the color of the value at the place p of the column.
+	color := func(column []Decimal, p int) (tcell.Color, bool) {
+		i := rows[p]
+		if i < 0 || i >= len(column) || column[i] == nil {
+			return tcell.ColorDefault, false
+		}
+
+		t := 0.0
+		if max > min {
+			t = (column[i].Float64() - min) / (max - min)
+		}
+
+		step := int(t*(heatmapColorSteps-1) + 0.5)
+		if step < 0 {
+			step = 0
+		}
+
+		if step >= heatmapColorSteps {
+			step = heatmapColorSteps - 1
+		}
+
+		c, ok := cache[step]
+		if !ok {
+			c = h.gradient.ScreenColor(float64(step)/(heatmapColorSteps-1), colors)
+			cache[step] = c
+		}
+
+		return c, true
+	}
+
+	for i, column := range data {
+		xx := x + i + (w - len(data))
+
+		for row := 0; row < height; row++ {
+			yy := y + height - row - 1
+
+			if !h.halfBlocks {
+				if c, ok := color(column, row); ok {
+					screen.SetContent(xx, yy, '█', nil, tcell.StyleDefault.Foreground(c))
+				}
+
+				continue
+			}
+
+			lower, lowerOK := color(column, 2*row)
+			upper, upperOK := color(column, 2*row+1)
+
+			switch {
+			case lowerOK && upperOK:
+				style := tcell.StyleDefault.Foreground(upper).Background(lower)
+				screen.SetContent(xx, yy, '▀', nil, style)
+			case upperOK:
+				screen.SetContent(xx, yy, '▀', nil, tcell.StyleDefault.Foreground(upper))
+			case lowerOK:
+				screen.SetContent(xx, yy, '▄', nil, tcell.StyleDefault.Foreground(lower))
+			}
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package tplot_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/jeremija/tplot"
+	"github.com/jeremija/tplot/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGradient(t *testing.T) {
+	black := tcell.NewRGBColor(0, 0, 0)
+	white := tcell.NewRGBColor(0xff, 0xff, 0xff)
+	red := tcell.NewRGBColor(0xff, 0, 0)
+
+	g := tplot.Gradient{black, white, red}
+
+	assert.Equal(t, black, g.Color(0))
+	assert.Equal(t, black, g.Color(-1))
+	assert.Equal(t, tcell.NewRGBColor(0x80, 0x80, 0x80), g.Color(0.25))
+	assert.Equal(t, white, g.Color(0.5))
+	assert.Equal(t, red, g.Color(1))
+	assert.Equal(t, red, g.Color(2))
+
+	assert.Equal(t, tcell.ColorDefault, tplot.Gradient(nil).Color(0.5))
+	assert.Equal(t, red, tplot.Gradient{red}.Color(0.5))
+
+	assert.Equal(t, white, g.ScreenColor(0.5, 1<<24))
+	assert.Equal(t, tcell.ColorRed, g.ScreenColor(1, 16))
+	assert.Equal(t, tcell.ColorGray, g.ScreenColor(0.25, 256))
+}
+
+func TestHeatmap(t *testing.T) {
+	var factory tplot.FloatFactory
+
+	d := func(values ...int64) []tplot.Decimal {
+		ret := make([]tplot.Decimal, len(values))
+
+		for i, v := range values {
+			ret[i] = factory.NewFromInt64(v)
+		}
+
+		return ret
+	}
+
+	black := tcell.NewRGBColor(0, 0, 0)
+	white := tcell.NewRGBColor(0xff, 0xff, 0xff)
+
+	p := tplot.NewHeatmap(factory)
+	p.SetGradient(tplot.Gradient{black, white})
+	p.SetData([][]tplot.Decimal{
+		d(0, 1, 2, 3),
+		d(3, 2),
+		{nil, factory.NewFromInt64(0)},
+	})
+	p.SetRowEdges(d(0, 10, 100, 1000, 10000))
+
+	a := tplot.NewAxis(factory)
+	box := tplot.NewAxisBox(a, p)
+	box.SetRect(0, 0, 9, 4)
+
+	scr := test.NewScreen()
+	box.Draw(scr)
+
+	exp := `
+      █
+ 100  █
+      ███
+   0  ██`
+
+	fmt.Println("== expected ==")
+	fmt.Println(exp)
+	fmt.Println("==  actual  ==")
+	fmt.Println(scr.Content())
+	fmt.Println("==============")
+
+	assert.Equal(t, exp, "\n"+scr.Content())
+
+	fg := func(x, y int) tcell.Color {
+		fg, _, _ := scr.Style(x, y).Decompose()
+		return fg
+	}
+
+	assert.Equal(t, white, fg(6, 0))
+	assert.Equal(t, black, fg(6, 3))
+	assert.Equal(t, white, fg(7, 3))
+	assert.Equal(t, black, fg(8, 2))
+
+	p.SetHalfBlocks(true)
+	box.SetRect(0, 0, 9, 2)
+
+	scr = test.NewScreen()
+	box.Draw(scr)
+
+	exp = `
+      ▀
+ 0    ▀▀▀`
+
+	fmt.Println("== expected ==")
+	fmt.Println(exp)
+	fmt.Println("==  actual  ==")
+	fmt.Println(scr.Content())
+	fmt.Println("==============")
+
+	assert.Equal(t, exp, "\n"+scr.Content())
+
+	fg1, bg1, _ := scr.Style(6, 0).Decompose()
+	assert.Equal(t, white, fg1)
+	assert.Equal(t, p.Gradient().Color(2.0/3), bg1)
+
+	assert.Equal(t, black, fg(8, 1))
+}
+
+func TestHeatmap_scale(t *testing.T) {
+	var factory tplot.FloatFactory
+
+	black := tcell.NewRGBColor(0, 0, 0)
+	white := tcell.NewRGBColor(0xff, 0xff, 0xff)
+
+	p := tplot.NewHeatmap(factory)
+	p.SetGradient(tplot.Gradient{black, white})
+	p.SetData([][]tplot.Decimal{{
+		factory.NewFromInt64(0),
+		factory.NewFromInt64(1),
+	}})
+	p.SetRowEdges([]tplot.Decimal{
+		factory.NewFromInt64(0),
+		factory.NewFromInt64(1),
+		factory.NewFromInt64(4),
+	})
+	p.SetScale(tplot.NewScaleLinear(factory))
+
+	a := tplot.NewAxis(factory)
+	box := tplot.NewAxisBox(a, p)
+	box.SetRect(0, 0, 3, 5)
+
+	scr := test.NewScreen()
+	box.Draw(scr)
+
+	// The lower row only takes the bottom place, unlike with the default
+	// scale, which gives both rows the same height.
+	exp := `
+ 4█
+  █
+ 2█
+  █
+ 0█`
+
+	fmt.Println("== expected ==")
+	fmt.Println(exp)
+	fmt.Println("==  actual  ==")
+	fmt.Println(scr.Content())
+	fmt.Println("==============")
+
+	assert.Equal(t, exp, "\n"+scr.Content())
+
+	for y, color := range []tcell.Color{white, white, white, white, black} {
+		fg, _, _ := scr.Style(2, y).Decompose()
+		assert.Equal(t, color, fg, "row %d", y)
+	}
+}
@@ -0,0 +1,339 @@
+package tplot
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// Histogram is a bar chart of the distribution of samples. The samples are
+// split into bins, and each bin is drawn as a bar of its sample count. Each
+// bin takes the same number of columns. The bin edges are labeled on the
+// horizontal axis below the bars, and the percentiles are marked with
+// vertical lines drawn over the bars.
+type Histogram struct {
+	*tview.Box
+
+	factory         DecimalFactory
+	samples         []Decimal
+	method          BinMethod
+	binCount        int
+	binWidth        Decimal
+	bars            *Bars
+	axis            *Axis
+	axisVisible     bool
+	percentiles     []float64
+	percentileStyle tcell.Style
+
+	// plot is the rect of the bars during the last Draw.
+	plot rect
+}
+
+var _ PlotArea = &Histogram{}
+
+// NewHistogram creates a new instance of Histogram.
+func NewHistogram(factory DecimalFactory) *Histogram {
+	axis := NewAxis(factory)
+	axis.SetDirection(DirectionHorizontal)
+
+	return &Histogram{
+		Box:             tview.NewBox(),
+		factory:         factory,
+		binCount:        10,
+		bars:            NewBars(factory),
+		axis:            axis,
+		axisVisible:     true,
+		percentileStyle: tcell.StyleDefault.Foreground(tcell.ColorDarkGray),
+	}
+}
+
+// SetSamples sets the samples.
+func (h *Histogram) SetSamples(samples []Decimal) {
+	h.samples = samples
+}
+
+// Samples returns the samples.
+func (h *Histogram) Samples() []Decimal {
+	return h.samples
+}
+
+// SetBinMethod sets how the samples are split into bins.
+func (h *Histogram) SetBinMethod(method BinMethod) {
+	h.method = method
+}
+
+// BinMethod returns how the samples are split into bins.
+func (h *Histogram) BinMethod() BinMethod {
+	return h.method
+}
+
+// SetBinCount sets the number of bins used by BinFixedCount and BinLog.
+func (h *Histogram) SetBinCount(count int) {
+	if count <= 0 {
+		count = 1
+	}
+
+	h.binCount = count
+}
+
+// BinCount returns the number of bins used by BinFixedCount and BinLog.
+func (h *Histogram) BinCount() int {
+	return h.binCount
+}
+
+// SetBinWidth sets the width of the bins used by BinFixedWidth. The number of
+// bins is used instead when the width is not positive.
+func (h *Histogram) SetBinWidth(width Decimal) {
+	h.binWidth = width
+}
+
+// BinWidth returns the width of the bins used by BinFixedWidth. May be nil.
+func (h *Histogram) BinWidth() Decimal {
+	return h.binWidth
+}
+
+// SetPercentiles sets the percentiles to mark, e.g. 50, 90 and 99.
+func (h *Histogram) SetPercentiles(percentiles []float64) {
+	h.percentiles = percentiles
+}
+
+// Percentiles returns the percentiles to mark.
+func (h *Histogram) Percentiles() []float64 {
+	return h.percentiles
+}
+
+// SetPercentileStyle sets the style of the percentile markers.
+func (h *Histogram) SetPercentileStyle(style tcell.Style) {
+	h.percentileStyle = style
+}
+
+// PercentileStyle returns the style of the percentile markers.
+func (h *Histogram) PercentileStyle() tcell.Style {
+	return h.percentileStyle
+}
+
+// SetStyle sets the style of the bars.
+func (h *Histogram) SetStyle(style tcell.Style) {
+	h.bars.SetStyle(style)
+}
+
+// Style returns the style of the bars.
+func (h *Histogram) Style() tcell.Style {
+	return h.bars.Style()
+}
+
+// SetRunes sets the runes of the bars.
+func (h *Histogram) SetRunes(runes []rune) {
+	h.bars.SetRunes(runes)
+}
+
+// Runes returns the runes of the bars.
+func (h *Histogram) Runes() []rune {
+	return h.bars.Runes()
+}
+
+// SetAxisVisible sets the visibility of the axis with the bin edges.
+func (h *Histogram) SetAxisVisible(visible bool) {
+	h.axisVisible = visible
+}
+
+// AxisVisible returns true when the axis with the bin edges is visible.
+func (h *Histogram) AxisVisible() bool {
+	return h.axisVisible
+}
+
+// Axis returns the horizontal axis with the bin edges, for example to set its
+// style or formatter.
+func (h *Histogram) Axis() *Axis {
+	return h.axis
+}
+
+// SetScale sets the scale of the counts.
+func (h *Histogram) SetScale(scale Scale) {
+	h.bars.SetScale(scale)
+}
+
+// Scale returns the scale of the counts.
+func (h *Histogram) Scale() Scale {
+	return h.bars.Scale()
+}
+
+// PlotRect implements PlotArea.
+func (h *Histogram) PlotRect() (int, int, int, int) {
+	return h.plot.x, h.plot.y, h.plot.w, h.plot.h
+}
+
+// values returns the sorted samples that can be binned.
+func (h *Histogram) values() []Decimal {
+	sorted := sortDecimals(h.samples)
+
+	if h.method != BinLog {
+		return sorted
+	}
+
+	zero := h.factory.Zero()
+
+	for i, value := range sorted {
+		if value.GreaterThan(zero) {
+			return sorted[i:]
+		}
+	}
+
+	return nil
+}
+
+// bins returns the bin edges and the bins for the sorted values.
+func (h *Histogram) bins(sorted []Decimal) ([]Decimal, []Bin) {
+	edges := binEdges(h.factory, sorted, h.method, h.binCount, h.binWidth)
+	if len(edges) < 2 {
+		return nil, nil
+	}
+
+	bins := make([]Bin, len(edges)-1)
+
+	for i := range bins {
+		bins[i].Min = edges[i]
+		bins[i].Max = edges[i+1]
+	}
+
+	for _, value := range sorted {
+		if i := binIndex(edges, value); i >= 0 {
+			bins[i].Count++
+		}
+	}
+
+	return edges, bins
+}
+
+// Bins returns the bins of the samples, from the lowest.
+func (h *Histogram) Bins() []Bin {
+	_, bins := h.bins(h.values())
+
+	return bins
+}
+
+// Percentile returns the p-th percentile of the samples using the nearest
+// rank method, where p is between 0 and 100. The value is not valid when
+// there are no samples.
+func (h *Histogram) Percentile(p float64) DecimalValue {
+	return percentile(h.values(), p)
+}
+
+// percentile returns the p-th percentile of the sorted values.
+func percentile(sorted []Decimal, p float64) DecimalValue {
+	n := len(sorted)
+
+	if n == 0 {
+		return DecimalValue{}
+	}
+
+	i := int(math.Ceil(p/100*float64(n))) - 1
+
+	if i < 0 {
+		i = 0
+	}
+
+	if i >= n {
+		i = n - 1
+	}
+
+	return DecimalValue{
+		Decimal: sorted[i],
+		Valid:   true,
+	}
+}
+
+// Draw implements tview.Primitive.
+func (h *Histogram) Draw(screen tcell.Screen) {
+	h.DrawForSubclass(screen, h)
+
+	x, y, w, height := h.GetInnerRect()
+
+	h.plot = rect{x: x, y: y}
+
+	if w == 0 || height == 0 {
+		return
+	}
+
+	axisH := 0
+	if h.axisVisible && height > 1 {
+		axisH = 1
+	}
+
+	barsH := height - axisH
+
+	h.plot = rect{x: x, y: y, w: w, h: barsH}
+
+	sorted := h.values()
+	edges, bins := h.bins(sorted)
+
+	if len(bins) == 0 {
+		return
+	}
+
+	scale := &binScale{
+		factory: h.factory,
+		edges:   edges,
+		size:    w,
+	}
+
+	// Each column is a bar with the count of its bin. When there are more
+	// bins than columns, the counts of the bins are summed.
+	counts := make([]int64, w)
+
+	for i, bin := range bins {
+		start, end := scale.column(i), scale.column(i+1)
+		if end == start {
+			end = start + 1
+		}
+
+		for col := start; col < end && col < w; col++ {
+			counts[col] += int64(bin.Count)
+		}
+	}
+
+	data := make([]Decimal, w)
+
+	for i, count := range counts {
+		data[i] = h.factory.NewFromInt64(count)
+	}
+
+	h.bars.Scale().SetSize(barsH)
+	h.bars.SetRect(x, y, w, barsH)
+	h.bars.SetData(data)
+	h.bars.Draw(screen)
+
+	for _, p := range h.percentiles {
+		value := percentile(sorted, p)
+		if !value.Valid {
+			continue
+		}
+
+		col := scale.Value(value.Decimal)
+		if col < 0 || col >= w {
+			continue
+		}
+
+		for row := 0; row < barsH; row++ {
+			screen.SetContent(x+col, y+row, '┊', nil, h.percentileStyle)
+		}
+
+		label := "p" + strconv.FormatFloat(p, 'f', -1, 64)
+
+		for i, ch := range []rune(label) {
+			if col+1+i >= w {
+				break
+			}
+
+			screen.SetContent(x+col+1+i, y, ch, nil, h.percentileStyle)
+		}
+	}
+
+	if axisH > 0 {
+		h.axis.SetScale(scale)
+		h.axis.SetRect(x, y+barsH, w, axisH)
+		h.axis.Draw(screen)
+	}
+}
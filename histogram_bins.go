@@ -0,0 +1,286 @@
+package tplot
+
+import (
+	"math"
+	"sort"
+	"strconv"
+)
+
+// maxHistogramBins limits the number of bins created by BinFixedWidth when
+// the width is small compared to the range of the samples. The width is
+// multiplied until the bins fit.
+const maxHistogramBins = 1000
+
+// BinMethod describes how Histogram splits the range of the samples into
+// bins.
+type BinMethod int
+
+const (
+	// BinFixedCount splits the range into a fixed number of bins of the same
+	// width.
+	BinFixedCount BinMethod = iota
+	// BinFixedWidth splits the range into bins of a fixed width, starting at
+	// a multiple of the width. The width is multiplied when the range would
+	// need more than a thousand bins.
+	BinFixedWidth
+	// BinLog splits the range into a fixed number of bins with
+	// logarithmically spaced edges, which suits samples like latencies. Only
+	// positive samples are binned.
+	BinLog
+)
+
+// Bin is a histogram bin that counts the samples from Min, inclusive, to
+// Max, exclusive. The last bin also includes Max.
+type Bin struct {
+	Min   Decimal
+	Max   Decimal
+	Count int
+}
+
+// sortDecimals returns a sorted copy of values.
+func sortDecimals(values []Decimal) []Decimal {
+	ret := make([]Decimal, len(values))
+	copy(ret, values)
+
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].LessThan(ret[j])
+	})
+
+	return ret
+}
+
+// binEdges returns the edges of the bins for the sorted samples. There is
+// one more edge than bins, and the edges are increasing.
+func binEdges(
+	factory DecimalFactory,
+	sorted []Decimal,
+	method BinMethod,
+	count int,
+	width Decimal,
+) []Decimal {
+	if len(sorted) == 0 {
+		return nil
+	}
+
+	if count <= 0 {
+		count = 1
+	}
+
+	min, max := sorted[0], sorted[len(sorted)-1]
+
+	if !max.GreaterThan(min) {
+		return []Decimal{min, min.Add(factory.NewFromInt64(1))}
+	}
+
+	switch {
+	case method == BinFixedWidth && width != nil && width.GreaterThan(factory.Zero()):
+		var start Decimal
+
+		n := 0
+
+		for {
+			start = factory.NewFromInt64(min.Div(width).IntPart()).Mul(width)
+			if start.GreaterThan(min) {
+				start = start.Sub(width)
+			}
+
+			n = int(max.Sub(start).Div(width).IntPart()) + 1
+			if n <= maxHistogramBins {
+				break
+			}
+
+			// Widen the bins instead of dropping the samples past the
+			// last bin.
+			k := (n + maxHistogramBins - 1) / maxHistogramBins
+			width = width.Mul(factory.NewFromInt64(int64(k)))
+		}
+
+		edges := make([]Decimal, n+1)
+
+		for i := range edges {
+			edges[i] = start.Add(factory.NewFromInt64(int64(i)).Mul(width))
+		}
+
+		return edges
+	case method == BinLog:
+		edges := make([]Decimal, count+1)
+		edges[0], edges[count] = min, max
+
+		minF, ratio := min.Float64(), max.Float64()/min.Float64()
+
+		for i := 1; i < count; i++ {
+			edge := minF * math.Pow(ratio, float64(i)/float64(count))
+			// Keep 15 significant digits to hide the rounding errors of
+			// math.Pow.
+			edge, _ = strconv.ParseFloat(strconv.FormatFloat(edge, 'g', 15, 64), 64)
+
+			edges[i] = NewFromFloat64(factory, edge)
+		}
+
+		return uniqueEdges(edges)
+	default:
+		edges := make([]Decimal, count+1)
+		diff := max.Sub(min)
+		n := factory.NewFromInt64(int64(count))
+
+		for i := range edges {
+			edges[i] = min.Add(diff.Mul(factory.NewFromInt64(int64(i))).Div(n))
+		}
+
+		return uniqueEdges(edges)
+	}
+}
+
+// uniqueEdges removes the edges that are not greater than the previous edge.
+// Such edges are caused by rounding, e.g. by a Fixed factory, and would make
+// bins of zero width.
+func uniqueEdges(edges []Decimal) []Decimal {
+	ret := edges[:1]
+
+	for _, edge := range edges[1:] {
+		if edge.GreaterThan(ret[len(ret)-1]) {
+			ret = append(ret, edge)
+		}
+	}
+
+	return ret
+}
+
+// binIndex returns the index of the bin that contains value, or -1.
+func binIndex(edges []Decimal, value Decimal) int {
+	n := len(edges) - 1
+
+	if n <= 0 || value.LessThan(edges[0]) || value.GreaterThan(edges[n]) {
+		return -1
+	}
+
+	i := sort.Search(len(edges), func(i int) bool {
+		return edges[i].GreaterThan(value)
+	}) - 1
+
+	if i >= n {
+		// The last bin includes its maximum.
+		i = n - 1
+	}
+
+	return i
+}
+
+// binScale is a Scale that gives each bin the same number of places
+// regardless of the bin width, so the ticks are the bin edges. Values within
+// a bin are interpolated linearly. It is used for the columns of a Histogram
+// and the rows of a Heatmap.
+type binScale struct {
+	factory DecimalFactory
+	edges   []Decimal
+	size    int
+}
+
+var (
+	_ Scale       = &binScale{}
+	_ ScaleTicker = &binScale{}
+)
+
+func (s *binScale) Copy() Scale {
+	b := *s
+	return &b
+}
+
+func (s *binScale) Size() int {
+	return s.size
+}
+
+func (s *binScale) SetSize(size int) {
+	s.size = size
+}
+
+// SetRange is a no-op because the range is defined by the bin edges.
+func (s *binScale) SetRange(Range) {}
+
+func (s *binScale) Range() Range {
+	rng := NewRange(s.factory)
+
+	if n := len(s.edges); n > 0 {
+		rng = rng.Feed(s.edges[0]).Feed(s.edges[n-1])
+	}
+
+	return rng
+}
+
+func (s *binScale) NumDecimals() int {
+	return tickDecimals(s.edges)
+}
+
+// column returns the first place of the bin at index i.
+func (s *binScale) column(i int) int {
+	return i * s.size / (len(s.edges) - 1)
+}
+
+// index returns the index of the bin drawn at place col.
+func (s *binScale) index(col int) int {
+	n := len(s.edges) - 1
+
+	return sort.Search(n, func(i int) bool {
+		return s.column(i+1) > col
+	})
+}
+
+func (s *binScale) Value(v Decimal) int {
+	n := len(s.edges) - 1
+
+	if n <= 0 || s.size <= 0 {
+		return 0
+	}
+
+	if v.LessThan(s.edges[0]) {
+		return -1
+	}
+
+	if v.GreaterThan(s.edges[n]) {
+		return s.size
+	}
+
+	i := binIndex(s.edges, v)
+
+	start, end := s.column(i), s.column(i+1)
+	min, max := s.edges[i], s.edges[i+1]
+
+	col := start
+
+	if max.GreaterThan(min) {
+		cols := s.factory.NewFromInt64(int64(end - start))
+		col += int(v.Sub(min).Mul(cols).Div(max.Sub(min)).IntPart())
+	}
+
+	// The maximum of the last bin is drawn in the last column.
+	if col >= s.size {
+		col = s.size - 1
+	}
+
+	return col
+}
+
+func (s *binScale) Reverse(col int) Decimal {
+	n := len(s.edges) - 1
+
+	if n <= 0 || s.size <= 0 {
+		return s.factory.Zero()
+	}
+
+	i := s.index(col)
+	if i >= n {
+		return s.edges[n]
+	}
+
+	start, end := s.column(i), s.column(i+1)
+	min, max := s.edges[i], s.edges[i+1]
+
+	offset := s.factory.NewFromInt64(int64(col - start))
+
+	return min.Add(max.Sub(min).Mul(offset).Div(s.factory.NewFromInt64(int64(end - start))))
+}
+
+// Ticks implements ScaleTicker. The ticks are the bin edges.
+func (s *binScale) Ticks(minGap int) []Decimal {
+	return s.edges
+}
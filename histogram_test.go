@@ -0,0 +1,176 @@
+package tplot_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/jeremija/tplot"
+	"github.com/jeremija/tplot/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHistogram_bins(t *testing.T) {
+	var factory tplot.FloatFactory
+
+	samples := func(values ...float64) []tplot.Decimal {
+		ret := make([]tplot.Decimal, len(values))
+
+		for i, v := range values {
+			ret[i] = tplot.Float(v)
+		}
+
+		return ret
+	}
+
+	type bin struct {
+		Min   float64
+		Max   float64
+		Count int
+	}
+
+	bins := func(h *tplot.Histogram) []bin {
+		var ret []bin
+
+		for _, b := range h.Bins() {
+			ret = append(ret, bin{b.Min.Float64(), b.Max.Float64(), b.Count})
+		}
+
+		return ret
+	}
+
+	h := tplot.NewHistogram(factory)
+	h.SetSamples(samples(4, 0, 1, 2, 2, 3, 8))
+	h.SetBinCount(4)
+
+	assert.Equal(t, []bin{
+		{0, 2, 2},
+		{2, 4, 3},
+		{4, 6, 1},
+		{6, 8, 1},
+	}, bins(h))
+
+	h.SetBinMethod(tplot.BinFixedWidth)
+	h.SetBinWidth(tplot.Float(3))
+
+	assert.Equal(t, []bin{
+		{0, 3, 4},
+		{3, 6, 2},
+		{6, 9, 1},
+	}, bins(h))
+
+	h.SetSamples(samples(-5, 1, 10, 100, 1000))
+	h.SetBinMethod(tplot.BinLog)
+	h.SetBinCount(3)
+
+	assert.Equal(t, []bin{
+		{1, 10, 1},
+		{10, 100, 1},
+		{100, 1000, 2},
+	}, bins(h))
+
+	h.SetSamples(samples(5, 5))
+
+	assert.Equal(t, []bin{{5, 6, 2}}, bins(h))
+
+	h.SetSamples(samples(0, 5000))
+	h.SetBinMethod(tplot.BinFixedWidth)
+	h.SetBinWidth(tplot.Float(1))
+
+	// The bins are widened to fit all samples.
+	widened := bins(h)
+	assert.Len(t, widened, 834)
+	assert.Equal(t, bin{0, 6, 1}, widened[0])
+	assert.Equal(t, bin{4998, 5004, 1}, widened[833])
+
+	h.SetSamples(nil)
+
+	assert.Empty(t, h.Bins())
+	assert.False(t, h.Percentile(50).Valid)
+}
+
+func TestHistogram_fixed(t *testing.T) {
+	factory := tplot.NewFixedFactory(0)
+
+	bins := func(h *tplot.Histogram) []string {
+		var ret []string
+
+		for _, b := range h.Bins() {
+			ret = append(ret, fmt.Sprintf("[%s,%s]=%d", b.Min, b.Max, b.Count))
+		}
+
+		return ret
+	}
+
+	h := tplot.NewHistogram(factory)
+	h.SetSamples([]tplot.Decimal{
+		factory.NewFromInt64(0),
+		factory.NewFromInt64(1),
+		factory.NewFromInt64(2),
+		factory.NewFromInt64(3),
+	})
+	h.SetBinCount(6)
+
+	assert.Equal(t, []string{
+		"[0,0.5]=1",
+		"[0.5,1]=0",
+		"[1,1.5]=1",
+		"[1.5,2]=0",
+		"[2,2.5]=1",
+		"[2.5,3]=1",
+	}, bins(h))
+
+	// Rounding to the scale of the division makes some edges equal, which
+	// are merged.
+	h.SetSamples([]tplot.Decimal{
+		factory.Zero(),
+		tplot.NewFixed(1, 17),
+	})
+	h.SetBinCount(10)
+
+	assert.Equal(t, []string{
+		"[0,0.00000000000000001]=2",
+	}, bins(h))
+}
+
+func TestHistogram(t *testing.T) {
+	var factory tplot.FloatFactory
+
+	h := tplot.NewHistogram(factory)
+	scr := test.NewScreen()
+
+	var samples []tplot.Decimal
+
+	for i, count := range []int{1, 3, 4, 2} {
+		for j := 0; j < count; j++ {
+			samples = append(samples, factory.NewFromInt64(int64(i*10+j)))
+		}
+	}
+
+	h.SetSamples(samples)
+	h.SetBinMethod(tplot.BinFixedWidth)
+	h.SetBinWidth(tplot.Float(10))
+	h.SetPercentiles([]float64{50, 90})
+
+	assert.Equal(t, tplot.Float(20), h.Percentile(50).Decimal)
+	assert.Equal(t, tplot.Float(30), h.Percentile(90).Decimal)
+	assert.Equal(t, tplot.Float(0), h.Percentile(0).Decimal)
+	assert.Equal(t, tplot.Float(31), h.Percentile(100).Decimal)
+
+	h.SetRect(0, 0, 16, 5)
+	h.Draw(scr)
+
+	exp := `
+        ┊p50┊p90
+    ████┊███┊
+    ████┊███┊███
+████████┊███┊███
+0   10  20  30`
+
+	fmt.Println("== expected ==")
+	fmt.Println(exp)
+	fmt.Println("==  actual  ==")
+	fmt.Println(scr.Content())
+	fmt.Println("==============")
+
+	assert.Equal(t, exp, "\n"+scr.Content())
+}
@@ -0,0 +1,8 @@
+package tplot
+
+// Indicator calculates a series of values from OHLC items, for example a
+// moving average. The returned slice must have the same length as items, with
+// invalid values for items that do not have enough history.
+type Indicator interface {
+	Calculate(items []OHLC) []DecimalValue
+}
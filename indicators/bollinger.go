@@ -0,0 +1,103 @@
+package indicators
+
+import (
+	"github.com/jeremija/tplot"
+)
+
+// Bollinger calculates the Bollinger bands: the simple moving average and
+// the bands k standard deviations above and below it. Use Upper, Middle and
+// Lower to draw each band.
+type Bollinger struct {
+	factory tplot.DecimalFactory
+	period  int
+	k       tplot.Decimal
+	source  Source
+}
+
+// NewBollinger creates new Bollinger bands over period items, k standard
+// deviations wide. The usual parameters are 20 and 2.
+func NewBollinger(factory tplot.DecimalFactory, period int, k tplot.Decimal) *Bollinger {
+	return &Bollinger{
+		factory: factory,
+		period:  period,
+		k:       k,
+		source:  Close,
+	}
+}
+
+// SetSource sets the source of the values. Close is used by default.
+func (b *Bollinger) SetSource(source Source) {
+	b.source = source
+}
+
+// Period returns the number of items used for calculating the bands.
+func (b *Bollinger) Period() int {
+	return b.period
+}
+
+// Upper returns the Indicator for the upper band.
+func (b *Bollinger) Upper() tplot.Indicator {
+	return bollingerBand{b, 1}
+}
+
+// Middle returns the Indicator for the middle band, the simple moving
+// average.
+func (b *Bollinger) Middle() tplot.Indicator {
+	return bollingerBand{b, 0}
+}
+
+// Lower returns the Indicator for the lower band.
+func (b *Bollinger) Lower() tplot.Indicator {
+	return bollingerBand{b, -1}
+}
+
+// Calculate calculates all three bands.
+func (b *Bollinger) Calculate(items []tplot.OHLC) (upper, middle, lower []tplot.DecimalValue) {
+	vals := values(items, b.source)
+	period := b.period
+
+	middle = sma(b.factory, vals, period)
+	upper = make([]tplot.DecimalValue, len(items))
+	lower = make([]tplot.DecimalValue, len(items))
+
+	for i, mean := range middle {
+		if !mean.Valid {
+			continue
+		}
+
+		variance := b.factory.Zero()
+
+		for _, value := range vals[i+1-period : i+1] {
+			diff := value.Decimal.Sub(mean.Decimal)
+			variance = variance.Add(diff.Mul(diff))
+		}
+
+		variance = variance.Div(b.factory.NewFromInt64(int64(period)))
+		width := sqrt(b.factory, variance).Mul(b.k)
+
+		upper[i] = valid(mean.Decimal.Add(width))
+		lower[i] = valid(mean.Decimal.Sub(width))
+	}
+
+	return upper, middle, lower
+}
+
+// bollingerBand is the Indicator for a single band. The band is 1 for upper,
+// 0 for middle and -1 for lower band.
+type bollingerBand struct {
+	bollinger *Bollinger
+	band      int
+}
+
+func (b bollingerBand) Calculate(items []tplot.OHLC) []tplot.DecimalValue {
+	upper, middle, lower := b.bollinger.Calculate(items)
+
+	switch b.band {
+	case 1:
+		return upper
+	case -1:
+		return lower
+	default:
+		return middle
+	}
+}
@@ -0,0 +1,38 @@
+package indicators
+
+import (
+	"github.com/jeremija/tplot"
+)
+
+// EMA is the exponential moving average.
+type EMA struct {
+	factory tplot.DecimalFactory
+	period  int
+	source  Source
+}
+
+var _ tplot.Indicator = &EMA{}
+
+// NewEMA creates a new exponential moving average over period items.
+func NewEMA(factory tplot.DecimalFactory, period int) *EMA {
+	return &EMA{
+		factory: factory,
+		period:  period,
+		source:  Close,
+	}
+}
+
+// SetSource sets the source of the values. Close is used by default.
+func (e *EMA) SetSource(source Source) {
+	e.source = source
+}
+
+// Period returns the number of items used for calculating the average.
+func (e *EMA) Period() int {
+	return e.period
+}
+
+// Calculate implements tplot.Indicator.
+func (e *EMA) Calculate(items []tplot.OHLC) []tplot.DecimalValue {
+	return ema(e.factory, values(items, e.source), e.period)
+}
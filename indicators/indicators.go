@@ -0,0 +1,140 @@
+// Package indicators contains technical indicators that can be calculated
+// from tplot.OHLC items and drawn using tplot.Overlay.
+package indicators
+
+import (
+	"github.com/jeremija/tplot"
+)
+
+// Source extracts the value used for calculating an indicator from an OHLC
+// item.
+type Source func(item tplot.OHLC) tplot.Decimal
+
+// Open is a Source that returns the open price.
+func Open(item tplot.OHLC) tplot.Decimal {
+	return item.O
+}
+
+// High is a Source that returns the high price.
+func High(item tplot.OHLC) tplot.Decimal {
+	return item.H
+}
+
+// Low is a Source that returns the low price.
+func Low(item tplot.OHLC) tplot.Decimal {
+	return item.L
+}
+
+// Close is a Source that returns the close price.
+func Close(item tplot.OHLC) tplot.Decimal {
+	return item.C
+}
+
+// values extracts the values from items using source.
+func values(items []tplot.OHLC, source Source) []tplot.DecimalValue {
+	ret := make([]tplot.DecimalValue, len(items))
+
+	for i, item := range items {
+		ret[i] = valid(source(item))
+	}
+
+	return ret
+}
+
+func valid(dec tplot.Decimal) tplot.DecimalValue {
+	return tplot.DecimalValue{
+		Decimal: dec,
+		Valid:   true,
+	}
+}
+
+// window returns true when there are period consecutive valid values ending at
+// index i.
+func window(values []tplot.DecimalValue, i int, period int) bool {
+	if period <= 0 || i+1 < period {
+		return false
+	}
+
+	for j := i + 1 - period; j <= i; j++ {
+		if !values[j].Valid {
+			return false
+		}
+	}
+
+	return true
+}
+
+// sma calculates the simple moving average of values.
+func sma(factory tplot.DecimalFactory, values []tplot.DecimalValue, period int) []tplot.DecimalValue {
+	ret := make([]tplot.DecimalValue, len(values))
+
+	for i := range values {
+		if !window(values, i, period) {
+			continue
+		}
+
+		sum := factory.Zero()
+
+		for _, value := range values[i+1-period : i+1] {
+			sum = sum.Add(value.Decimal)
+		}
+
+		ret[i] = valid(sum.Div(factory.NewFromInt64(int64(period))))
+	}
+
+	return ret
+}
+
+// ema calculates the exponential moving average of values. The first value
+// is the simple moving average of the first period values. Invalid values
+// restart the calculation.
+func ema(factory tplot.DecimalFactory, values []tplot.DecimalValue, period int) []tplot.DecimalValue {
+	ret := make([]tplot.DecimalValue, len(values))
+
+	// alpha is the smoothing factor.
+	alpha := factory.NewFromInt64(2).Div(factory.NewFromInt64(int64(period + 1)))
+
+	averages := sma(factory, values, period)
+
+	for i, value := range values {
+		switch {
+		case !value.Valid:
+		case i > 0 && ret[i-1].Valid:
+			prev := ret[i-1].Decimal
+			ret[i] = valid(value.Decimal.Sub(prev).Mul(alpha).Add(prev))
+		default:
+			ret[i] = averages[i]
+		}
+	}
+
+	return ret
+}
+
+// sqrt calculates the square root of dec using Newton's method.
+func sqrt(factory tplot.DecimalFactory, dec tplot.Decimal) tplot.Decimal {
+	zero := factory.Zero()
+
+	if !dec.GreaterThan(zero) {
+		return zero
+	}
+
+	one := factory.NewFromInt64(1)
+	two := factory.NewFromInt64(2)
+
+	x := dec
+	if x.LessThan(one) {
+		x = one
+	}
+
+	for i := 0; i < 100; i++ {
+		next := x.Add(dec.Div(x)).Div(two)
+
+		if next.Equal(x) {
+			break
+		}
+
+		x = next
+	}
+
+	return x
+}
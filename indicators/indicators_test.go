@@ -0,0 +1,145 @@
+package indicators_test
+
+import (
+	"testing"
+
+	"github.com/jeremija/tplot"
+	"github.com/jeremija/tplot/indicators"
+	"github.com/stretchr/testify/assert"
+)
+
+var factory tplot.FloatFactory
+
+// newItems creates items with the close prices set to closes, and all other
+// prices set to the close price.
+func newItems(closes ...float64) []tplot.OHLC {
+	items := make([]tplot.OHLC, len(closes))
+
+	for i, c := range closes {
+		items[i] = tplot.OHLC{
+			O: tplot.Float(c),
+			H: tplot.Float(c),
+			L: tplot.Float(c),
+			C: tplot.Float(c),
+			V: tplot.Float(1),
+		}
+	}
+
+	return items
+}
+
+// floats converts values to floats for easier comparison. Invalid values are
+// converted to -1.
+func floats(values []tplot.DecimalValue) []float64 {
+	ret := make([]float64, len(values))
+
+	for i, value := range values {
+		if !value.Valid {
+			ret[i] = -1
+			continue
+		}
+
+		ret[i] = value.Decimal.Float64()
+	}
+
+	return ret
+}
+
+func TestSMA(t *testing.T) {
+	sma := indicators.NewSMA(factory, 3)
+
+	values := sma.Calculate(newItems(1, 2, 3, 4, 5, 6))
+
+	assert.Equal(t, []float64{-1, -1, 2, 3, 4, 5}, floats(values))
+}
+
+func TestEMA(t *testing.T) {
+	ema := indicators.NewEMA(factory, 3)
+
+	values := ema.Calculate(newItems(1, 2, 3, 4, 5, 6))
+
+	assert.Equal(t, []float64{-1, -1, 2, 3, 4, 5}, floats(values))
+
+	values = ema.Calculate(newItems(2, 2, 2, 6))
+
+	assert.Equal(t, []float64{-1, -1, 2, 4}, floats(values))
+}
+
+func TestWMA(t *testing.T) {
+	wma := indicators.NewWMA(factory, 3)
+
+	values := wma.Calculate(newItems(3, 6, 3, 6))
+
+	assert.Equal(t, []float64{-1, -1, 4, 5}, floats(values))
+}
+
+func TestVWAP(t *testing.T) {
+	vwap := indicators.NewVWAP(factory)
+
+	items := newItems(2, 4, 8)
+	items[1].V = tplot.Float(3)
+
+	values := vwap.Calculate(items)
+
+	assert.Equal(t, []float64{2, 3.5, 4.4}, floats(values))
+
+	vwap.SetPeriod(2)
+
+	values = vwap.Calculate(items)
+
+	assert.Equal(t, []float64{-1, 3.5, 5}, floats(values))
+}
+
+func TestBollinger(t *testing.T) {
+	bollinger := indicators.NewBollinger(factory, 4, tplot.Float(2))
+
+	items := newItems(2, 4, 4, 6, 6)
+
+	upper, middle, lower := bollinger.Calculate(items)
+
+	assert.Equal(t, []float64{-1, -1, -1, 4, 5}, floats(middle))
+	assert.InDeltaSlice(t, []float64{-1, -1, -1, 6.828427, 7}, floats(upper), 0.00001)
+	assert.InDeltaSlice(t, []float64{-1, -1, -1, 1.171572, 3}, floats(lower), 0.00001)
+
+	assert.Equal(t, upper, bollinger.Upper().Calculate(items))
+	assert.Equal(t, middle, bollinger.Middle().Calculate(items))
+	assert.Equal(t, lower, bollinger.Lower().Calculate(items))
+}
+
+func TestRSI(t *testing.T) {
+	rsi := indicators.NewRSI(factory, 2)
+
+	values := rsi.Calculate(newItems(1, 2, 3, 2))
+
+	assert.Equal(t, []float64{-1, -1, 100, 50}, floats(values))
+}
+
+func TestMACD(t *testing.T) {
+	macd := indicators.NewMACD(factory, 1, 2, 2)
+
+	items := newItems(1, 2, 4)
+
+	line, signal, histogram := macd.Calculate(items)
+
+	assert.InDeltaSlice(t, []float64{-1, 0.5, 0.833333}, floats(line), 0.00001)
+	assert.InDeltaSlice(t, []float64{-1, -1, 0.666667}, floats(signal), 0.00001)
+	assert.InDeltaSlice(t, []float64{-1, -1, 0.166667}, floats(histogram), 0.00001)
+
+	assert.Equal(t, line, macd.Line().Calculate(items))
+	assert.Equal(t, signal, macd.Signal().Calculate(items))
+	assert.Equal(t, histogram, macd.Histogram().Calculate(items))
+}
+
+func TestStochastic(t *testing.T) {
+	stochastic := indicators.NewStochastic(factory, 3, 2)
+
+	items := newItems(1, 2, 3, 2)
+
+	k, d := stochastic.Calculate(items)
+
+	assert.Equal(t, []float64{-1, -1, 100, 0}, floats(k))
+	assert.Equal(t, []float64{-1, -1, -1, 50}, floats(d))
+
+	assert.Equal(t, k, stochastic.K().Calculate(items))
+	assert.Equal(t, d, stochastic.D().Calculate(items))
+}
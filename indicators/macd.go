@@ -0,0 +1,96 @@
+package indicators
+
+import (
+	"github.com/jeremija/tplot"
+)
+
+// MACD is the moving average convergence divergence. The MACD line is the
+// difference between the fast and the slow EMA, the signal line is the EMA of
+// the MACD line, and the histogram is the difference between the two. Use
+// Line, Signal and Histogram to draw each series.
+type MACD struct {
+	factory tplot.DecimalFactory
+	fast    int
+	slow    int
+	signal  int
+	source  Source
+}
+
+// NewMACD creates a new MACD. The usual periods are 12, 26 and 9.
+func NewMACD(factory tplot.DecimalFactory, fast, slow, signal int) *MACD {
+	return &MACD{
+		factory: factory,
+		fast:    fast,
+		slow:    slow,
+		signal:  signal,
+		source:  Close,
+	}
+}
+
+// SetSource sets the source of the values. Close is used by default.
+func (m *MACD) SetSource(source Source) {
+	m.source = source
+}
+
+// Line returns the Indicator for the MACD line.
+func (m *MACD) Line() tplot.Indicator {
+	return macdSeries{m, 0}
+}
+
+// Signal returns the Indicator for the signal line.
+func (m *MACD) Signal() tplot.Indicator {
+	return macdSeries{m, 1}
+}
+
+// Histogram returns the Indicator for the histogram. It should be drawn
+// using tplot.OverlayHistogram.
+func (m *MACD) Histogram() tplot.Indicator {
+	return macdSeries{m, 2}
+}
+
+// Calculate calculates the MACD line, the signal line and the histogram.
+func (m *MACD) Calculate(items []tplot.OHLC) (line, signal, histogram []tplot.DecimalValue) {
+	vals := values(items, m.source)
+
+	fast := ema(m.factory, vals, m.fast)
+	slow := ema(m.factory, vals, m.slow)
+
+	line = make([]tplot.DecimalValue, len(items))
+
+	for i := range items {
+		if fast[i].Valid && slow[i].Valid {
+			line[i] = valid(fast[i].Decimal.Sub(slow[i].Decimal))
+		}
+	}
+
+	signal = ema(m.factory, line, m.signal)
+	histogram = make([]tplot.DecimalValue, len(items))
+
+	for i := range items {
+		if line[i].Valid && signal[i].Valid {
+			histogram[i] = valid(line[i].Decimal.Sub(signal[i].Decimal))
+		}
+	}
+
+	return line, signal, histogram
+}
+
+// macdSeries is the Indicator for a single MACD series. The series is 0 for
+// MACD line, 1 for signal line and 2 for histogram.
+type macdSeries struct {
+	macd   *MACD
+	series int
+}
+
+func (m macdSeries) Calculate(items []tplot.OHLC) []tplot.DecimalValue {
+	line, signal, histogram := m.macd.Calculate(items)
+
+	switch m.series {
+	case 1:
+		return signal
+	case 2:
+		return histogram
+	default:
+		return line
+	}
+}
@@ -0,0 +1,92 @@
+package indicators
+
+import (
+	"github.com/jeremija/tplot"
+)
+
+// RSI is the relative strength index, using Wilder's smoothing. The values
+// are between 0 and 100.
+type RSI struct {
+	factory tplot.DecimalFactory
+	period  int
+	source  Source
+}
+
+var _ tplot.Indicator = &RSI{}
+
+// NewRSI creates a new relative strength index over period items. The usual
+// period is 14.
+func NewRSI(factory tplot.DecimalFactory, period int) *RSI {
+	return &RSI{
+		factory: factory,
+		period:  period,
+		source:  Close,
+	}
+}
+
+// SetSource sets the source of the values. Close is used by default.
+func (r *RSI) SetSource(source Source) {
+	r.source = source
+}
+
+// Period returns the number of items used for calculating the index.
+func (r *RSI) Period() int {
+	return r.period
+}
+
+// Calculate implements tplot.Indicator.
+func (r *RSI) Calculate(items []tplot.OHLC) []tplot.DecimalValue {
+	ret := make([]tplot.DecimalValue, len(items))
+
+	period := r.period
+	if period <= 0 || len(items) <= period {
+		return ret
+	}
+
+	zero := r.factory.Zero()
+	hundred := r.factory.NewFromInt64(100)
+	one := r.factory.NewFromInt64(1)
+	periodDec := r.factory.NewFromInt64(int64(period))
+	prevWeight := r.factory.NewFromInt64(int64(period - 1))
+
+	avgGain := zero
+	avgLoss := zero
+
+	for i := 1; i < len(items); i++ {
+		change := r.source(items[i]).Sub(r.source(items[i-1]))
+
+		gain, loss := zero, zero
+
+		if change.GreaterThan(zero) {
+			gain = change
+		} else {
+			loss = zero.Sub(change)
+		}
+
+		if i <= period {
+			avgGain = avgGain.Add(gain)
+			avgLoss = avgLoss.Add(loss)
+
+			if i < period {
+				continue
+			}
+
+			avgGain = avgGain.Div(periodDec)
+			avgLoss = avgLoss.Div(periodDec)
+		} else {
+			avgGain = avgGain.Mul(prevWeight).Add(gain).Div(periodDec)
+			avgLoss = avgLoss.Mul(prevWeight).Add(loss).Div(periodDec)
+		}
+
+		if avgLoss.IsZero() {
+			ret[i] = valid(hundred)
+			continue
+		}
+
+		rs := avgGain.Div(avgLoss)
+
+		ret[i] = valid(hundred.Sub(hundred.Div(one.Add(rs))))
+	}
+
+	return ret
+}
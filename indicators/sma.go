@@ -0,0 +1,38 @@
+package indicators
+
+import (
+	"github.com/jeremija/tplot"
+)
+
+// SMA is the simple moving average.
+type SMA struct {
+	factory tplot.DecimalFactory
+	period  int
+	source  Source
+}
+
+var _ tplot.Indicator = &SMA{}
+
+// NewSMA creates a new simple moving average over period items.
+func NewSMA(factory tplot.DecimalFactory, period int) *SMA {
+	return &SMA{
+		factory: factory,
+		period:  period,
+		source:  Close,
+	}
+}
+
+// SetSource sets the source of the values. Close is used by default.
+func (s *SMA) SetSource(source Source) {
+	s.source = source
+}
+
+// Period returns the number of items used for calculating the average.
+func (s *SMA) Period() int {
+	return s.period
+}
+
+// Calculate implements tplot.Indicator.
+func (s *SMA) Calculate(items []tplot.OHLC) []tplot.DecimalValue {
+	return sma(s.factory, values(items, s.source), s.period)
+}
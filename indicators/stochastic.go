@@ -0,0 +1,91 @@
+package indicators
+
+import (
+	"github.com/jeremija/tplot"
+)
+
+// Stochastic is the stochastic oscillator. %K is the position of the close
+// price within the high-low range of the last kPeriod items, and %D is the
+// simple moving average of %K over dPeriod items. The values are between 0
+// and 100. Use K and D to draw each line.
+type Stochastic struct {
+	factory tplot.DecimalFactory
+	kPeriod int
+	dPeriod int
+}
+
+// NewStochastic creates a new stochastic oscillator. The usual periods are 14
+// and 3.
+func NewStochastic(factory tplot.DecimalFactory, kPeriod, dPeriod int) *Stochastic {
+	return &Stochastic{
+		factory: factory,
+		kPeriod: kPeriod,
+		dPeriod: dPeriod,
+	}
+}
+
+// K returns the Indicator for the %K line.
+func (s *Stochastic) K() tplot.Indicator {
+	return stochasticLine{s, false}
+}
+
+// D returns the Indicator for the %D line.
+func (s *Stochastic) D() tplot.Indicator {
+	return stochasticLine{s, true}
+}
+
+// Calculate calculates the %K and %D lines.
+func (s *Stochastic) Calculate(items []tplot.OHLC) (k, d []tplot.DecimalValue) {
+	k = make([]tplot.DecimalValue, len(items))
+
+	period := s.kPeriod
+	hundred := s.factory.NewFromInt64(100)
+
+	for i := range items {
+		if period <= 0 || i+1 < period {
+			continue
+		}
+
+		low := items[i+1-period].L
+		high := items[i+1-period].H
+
+		for _, item := range items[i+2-period : i+1] {
+			if item.L.LessThan(low) {
+				low = item.L
+			}
+
+			if item.H.GreaterThan(high) {
+				high = item.H
+			}
+		}
+
+		rng := high.Sub(low)
+
+		if rng.IsZero() {
+			k[i] = valid(s.factory.NewFromInt64(50))
+			continue
+		}
+
+		k[i] = valid(items[i].C.Sub(low).Mul(hundred).Div(rng))
+	}
+
+	d = sma(s.factory, k, s.dPeriod)
+
+	return k, d
+}
+
+// stochasticLine is the Indicator for a single line of the Stochastic.
+type stochasticLine struct {
+	stochastic *Stochastic
+	d          bool
+}
+
+func (s stochasticLine) Calculate(items []tplot.OHLC) []tplot.DecimalValue {
+	k, d := s.stochastic.Calculate(items)
+
+	if s.d {
+		return d
+	}
+
+	return k
+}
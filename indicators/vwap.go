@@ -0,0 +1,73 @@
+package indicators
+
+import (
+	"github.com/jeremija/tplot"
+)
+
+// VWAP is the volume weighted average price, calculated from the typical
+// price (H+L+C)/3 of each item.
+type VWAP struct {
+	factory tplot.DecimalFactory
+	period  int
+}
+
+var _ tplot.Indicator = &VWAP{}
+
+// NewVWAP creates a new cumulative volume weighted average price.
+func NewVWAP(factory tplot.DecimalFactory) *VWAP {
+	return &VWAP{
+		factory: factory,
+	}
+}
+
+// SetPeriod sets the number of items for calculating a rolling VWAP. When
+// period is zero, VWAP is calculated cumulatively from the first item.
+func (v *VWAP) SetPeriod(period int) {
+	if period < 0 {
+		period = 0
+	}
+
+	v.period = period
+}
+
+// Period returns the number of items for calculating a rolling VWAP.
+func (v *VWAP) Period() int {
+	return v.period
+}
+
+// Calculate implements tplot.Indicator.
+func (v *VWAP) Calculate(items []tplot.OHLC) []tplot.DecimalValue {
+	ret := make([]tplot.DecimalValue, len(items))
+	three := v.factory.NewFromInt64(3)
+
+	// priceVolumes contains the typical price multiplied by volume.
+	priceVolumes := make([]tplot.Decimal, len(items))
+
+	sumPV := v.factory.Zero()
+	sumV := v.factory.Zero()
+
+	for i, item := range items {
+		typical := item.H.Add(item.L).Add(item.C).Div(three)
+		priceVolumes[i] = typical.Mul(item.V)
+
+		sumPV = sumPV.Add(priceVolumes[i])
+		sumV = sumV.Add(item.V)
+
+		if p := v.period; p > 0 && i >= p {
+			sumPV = sumPV.Sub(priceVolumes[i-p])
+			sumV = sumV.Sub(items[i-p].V)
+		}
+
+		if p := v.period; p > 0 && i+1 < p {
+			continue
+		}
+
+		if sumV.IsZero() {
+			continue
+		}
+
+		ret[i] = valid(sumPV.Div(sumV))
+	}
+
+	return ret
+}
@@ -0,0 +1,60 @@
+package indicators
+
+import (
+	"github.com/jeremija/tplot"
+)
+
+// WMA is the linearly weighted moving average. The most recent item has the
+// weight of period, and the oldest one the weight of 1.
+type WMA struct {
+	factory tplot.DecimalFactory
+	period  int
+	source  Source
+}
+
+var _ tplot.Indicator = &WMA{}
+
+// NewWMA creates a new weighted moving average over period items.
+func NewWMA(factory tplot.DecimalFactory, period int) *WMA {
+	return &WMA{
+		factory: factory,
+		period:  period,
+		source:  Close,
+	}
+}
+
+// SetSource sets the source of the values. Close is used by default.
+func (w *WMA) SetSource(source Source) {
+	w.source = source
+}
+
+// Period returns the number of items used for calculating the average.
+func (w *WMA) Period() int {
+	return w.period
+}
+
+// Calculate implements tplot.Indicator.
+func (w *WMA) Calculate(items []tplot.OHLC) []tplot.DecimalValue {
+	vals := values(items, w.source)
+	ret := make([]tplot.DecimalValue, len(vals))
+
+	period := w.period
+	divisor := w.factory.NewFromInt64(int64(period * (period + 1) / 2))
+
+	for i := range vals {
+		if !window(vals, i, period) {
+			continue
+		}
+
+		sum := w.factory.Zero()
+
+		for j, value := range vals[i+1-period : i+1] {
+			weight := w.factory.NewFromInt64(int64(j + 1))
+			sum = sum.Add(value.Decimal.Mul(weight))
+		}
+
+		ret[i] = valid(sum.Div(divisor))
+	}
+
+	return ret
+}
@@ -0,0 +1,245 @@
+package tplot
+
+import (
+	"unicode/utf8"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// DefaultLegendMarker is the swatch of a LegendItem without a marker.
+const DefaultLegendMarker = '■'
+
+// legendDecimals is the precision passed to the formatter of the values.
+const legendDecimals = 2
+
+// legendGap is the number of columns between the items of a horizontal
+// legend.
+const legendGap = 2
+
+// LegendItem is a named series listed in a Legend.
+type LegendItem struct {
+	// Name of the series.
+	Name string
+	// Style of the series, used to draw the swatch.
+	Style tcell.Style
+	// Marker is the swatch rune. DefaultLegendMarker is used when the
+	// marker is not set.
+	Marker rune
+	// Value is the latest value of the series, which is drawn after the name
+	// when valid.
+	Value DecimalValue
+}
+
+// SeriesLegendItems returns the legend items of the series, with the last
+// values of the series.
+func SeriesLegendItems(series []Series) []LegendItem {
+	ret := make([]LegendItem, len(series))
+
+	for i, s := range series {
+		ret[i] = LegendItem{
+			Name:  s.Name,
+			Style: s.Style,
+		}
+
+		if l := len(s.Data); l > 0 {
+			ret[i].Value = DecimalValue{
+				Decimal: s.Data[l-1],
+				Valid:   true,
+			}
+		}
+	}
+
+	return ret
+}
+
+// ScatterLegendItems returns the legend items of the scatter series, with
+// their markers.
+func ScatterLegendItems(series []ScatterSeries) []LegendItem {
+	ret := make([]LegendItem, len(series))
+
+	for i, s := range series {
+		marker := s.Marker
+		if marker == 0 {
+			marker = DefaultScatterMarker
+		}
+
+		ret[i] = LegendItem{
+			Name:   s.Name,
+			Style:  s.Style,
+			Marker: marker,
+		}
+	}
+
+	return ret
+}
+
+// Legend lists the names of the series with their style swatches and latest
+// values. A vertical legend lists one item per row, and a horizontal legend
+// lists all items in one row. See also AxisBox.SetLegend.
+type Legend struct {
+	*tview.Box
+
+	items     []LegendItem
+	direction Direction
+	style     tcell.Style
+	formatter Formatter
+}
+
+// NewLegend creates a new instance of Legend.
+func NewLegend() *Legend {
+	return &Legend{
+		Box:       tview.NewBox(),
+		direction: DirectionVertical,
+		style:     tcell.StyleDefault,
+		formatter: DefaultFormatter,
+	}
+}
+
+// SetItems sets the items.
+func (l *Legend) SetItems(items []LegendItem) {
+	l.items = items
+}
+
+// Items returns the items.
+func (l *Legend) Items() []LegendItem {
+	return l.items
+}
+
+// SetDirection sets the direction of the items.
+func (l *Legend) SetDirection(direction Direction) {
+	l.direction = direction
+}
+
+// Direction returns the direction of the items.
+func (l *Legend) Direction() Direction {
+	return l.direction
+}
+
+// SetStyle sets the style of the names and the values.
+func (l *Legend) SetStyle(style tcell.Style) {
+	l.style = style
+}
+
+// Style returns the style of the names and the values.
+func (l *Legend) Style() tcell.Style {
+	return l.style
+}
+
+// SetFormatter sets the formatter of the values. DefaultFormatter is used
+// when formatter is nil.
+func (l *Legend) SetFormatter(formatter Formatter) {
+	if formatter == nil {
+		formatter = DefaultFormatter
+	}
+
+	l.formatter = formatter
+}
+
+// Formatter returns the formatter of the values.
+func (l *Legend) Formatter() Formatter {
+	return l.formatter
+}
+
+// label returns the text of the item after the swatch.
+func (l *Legend) label(item LegendItem) string {
+	label := " " + item.Name
+
+	if item.Value.Valid {
+		label += " " + l.formatter(item.Value.Decimal, legendDecimals)
+	}
+
+	return label
+}
+
+// itemWidth returns the number of columns of the item.
+func (l *Legend) itemWidth(item LegendItem) int {
+	return 1 + utf8.RuneCountInString(l.label(item))
+}
+
+// CalcSize returns the size needed to draw all items, including the border.
+func (l *Legend) CalcSize() (int, int) {
+	width, height := 0, 0
+
+	for i, item := range l.items {
+		w := l.itemWidth(item)
+
+		if l.direction == DirectionHorizontal {
+			if i > 0 {
+				width += legendGap
+			}
+
+			width += w
+			height = 1
+
+			continue
+		}
+
+		if w > width {
+			width = w
+		}
+
+		height++
+	}
+
+	frameW, frameH := l.frame()
+
+	return width + frameW, height + frameH
+}
+
+// frame returns the number of columns and rows taken by the border and the
+// padding of the box.
+func (l *Legend) frame() (int, int) {
+	x, y, w, h := l.GetRect()
+	defer l.SetRect(x, y, w, h)
+
+	// Any size larger than the border and the padding.
+	const size = 100
+
+	l.SetRect(0, 0, size, size)
+	_, _, iw, ih := l.GetInnerRect()
+
+	return size - iw, size - ih
+}
+
+// Draw implements tview.Primitive.
+func (l *Legend) Draw(screen tcell.Screen) {
+	l.DrawForSubclass(screen, l)
+
+	x, y, w, h := l.GetInnerRect()
+
+	col, row := 0, 0
+
+	for _, item := range l.items {
+		if row >= h {
+			return
+		}
+
+		marker := item.Marker
+		if marker == 0 {
+			marker = DefaultLegendMarker
+		}
+
+		if l.direction == DirectionHorizontal && col > 0 {
+			col += legendGap
+		}
+
+		if col < w {
+			screen.SetContent(x+col, y+row, marker, nil, item.Style)
+		}
+
+		for i, ch := range []rune(l.label(item)) {
+			if col+1+i >= w {
+				break
+			}
+
+			screen.SetContent(x+col+1+i, y+row, ch, nil, l.style)
+		}
+
+		if l.direction == DirectionHorizontal {
+			col += l.itemWidth(item)
+		} else {
+			row++
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package tplot_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/jeremija/tplot"
+	"github.com/jeremija/tplot/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLegend(t *testing.T) {
+	var factory tplot.FloatFactory
+
+	series := []tplot.Series{
+		{
+			Name:  "rx",
+			Style: tcell.StyleDefault.Foreground(tcell.ColorGreen),
+			Data:  []tplot.Decimal{factory.NewFromInt64(1), factory.NewFromInt64(4)},
+		},
+		{
+			Name:  "tx",
+			Style: tcell.StyleDefault.Foreground(tcell.ColorBlue),
+			Data:  []tplot.Decimal{factory.NewFromInt64(3), factory.NewFromInt64(2)},
+		},
+	}
+
+	legend := tplot.NewLegend()
+	legend.SetItems(tplot.SeriesLegendItems(series))
+
+	w, h := legend.CalcSize()
+	assert.Equal(t, []int{9, 2}, []int{w, h})
+
+	legend.SetBorder(true)
+
+	w, h = legend.CalcSize()
+	assert.Equal(t, []int{11, 4}, []int{w, h})
+
+	legend.SetBorder(false)
+
+	scr := test.NewScreen()
+	legend.SetRect(0, 0, 9, 2)
+	legend.Draw(scr)
+
+	exp := `
+■ rx 4.00
+■ tx 2.00`
+
+	fmt.Println("== expected ==")
+	fmt.Println(exp)
+	fmt.Println("==  actual  ==")
+	fmt.Println(scr.Content())
+	fmt.Println("==============")
+
+	assert.Equal(t, exp, "\n"+scr.Content())
+
+	fg, _, _ := scr.Style(0, 1).Decompose()
+	assert.Equal(t, tcell.ColorBlue, fg)
+
+	bars := tplot.NewMultiBars(factory)
+	bars.SetSeries(series)
+	bars.SetSpacing(2)
+
+	box := tplot.NewAxisBox(tplot.NewAxis(factory), bars)
+	box.SetLegend(legend, tplot.Top)
+	box.SetRect(0, 0, 24, 5)
+
+	scr = test.NewScreen()
+	box.Draw(scr)
+
+	exp = `
+■ rx 4.00  ■ tx 2.00
+                      █
+ 5                  ▅ ▅
+                    █ █
+ 0                  ▅ █`
+
+	fmt.Println("== expected ==")
+	fmt.Println(exp)
+	fmt.Println("==  actual  ==")
+	fmt.Println(scr.Content())
+	fmt.Println("==============")
+
+	assert.Equal(t, exp, "\n"+scr.Content())
+
+	legend.SetItems(legend.Items()[:1])
+	box.SetLegend(legend, tplot.TopLeft)
+
+	scr = test.NewScreen()
+	box.Draw(scr)
+
+	exp = `
+  ■ rx 4.00           █
+ 5                  ▂ ▂
+                    █ █
+                    █ █
+ 0                  ▆ █`
+
+	fmt.Println("== expected ==")
+	fmt.Println(exp)
+	fmt.Println("==  actual  ==")
+	fmt.Println(scr.Content())
+	fmt.Println("==============")
+
+	assert.Equal(t, exp, "\n"+scr.Content())
+}
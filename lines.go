@@ -0,0 +1,159 @@
+package tplot
+
+import (
+	"github.com/gdamore/tcell/v2"
+)
+
+// Lines is a line chart that connects consecutive data points. Steep jumps
+// are drawn as vertical segments so the line is always continuous.
+type Lines struct {
+	*base
+}
+
+// DefaultLinesRunes contains the box drawing characters used to draw the
+// line, in the following order: horizontal, vertical, down and right, down
+// and left, up and right, up and left.
+var DefaultLinesRunes = []rune{'─', '│', '╭', '╮', '╰', '╯'}
+
+// NewLines creates a new instance of Lines.
+func NewLines(factory DecimalFactory) *Lines {
+	return &Lines{
+		base: newBase(factory, DefaultLinesRunes),
+	}
+}
+
+// Draw implements tview.Primitive.
+func (b *Lines) Draw(screen tcell.Screen) {
+	b.DrawForSubclass(screen, b)
+
+	data, start := b.dataWindow()
+	scale := b.scale
+	spacing := b.spacing
+	runes := b.runes
+	style := b.style
+	x, y, w, h := b.GetInnerRect()
+
+	if h == 0 || w == 0 {
+		return
+	}
+
+	if b.renderMode == RenderBraille {
+		b.drawBraille(screen, data)
+		b.drawAnnotations(screen, start, data.Len())
+
+		return
+	}
+
+	rng := b.calcRange(data)
+	scale.SetRange(rng)
+	// If we're sharing the scale with other components.
+	scale = scale.Copy()
+	scale.SetSize(h)
+
+	l := data.Len()
+	values := make([]DecimalValue, l)
+
+	for i := 0; i < l; i++ {
+		values[i] = DecimalValue{Decimal: data.At(i), Valid: true}
+	}
+
+	drawLine(screen, rect{x: x, y: y, w: w, h: h}, scale, spacing, values, runes, style)
+	b.drawAnnotations(screen, start, l)
+}
+
+// drawLine draws values aligned to the right edge of r and connects the
+// consecutive valid values. The scale must already have the size set to the
+// height of r. Values outside of the scale are drawn at the edge of r.
+func drawLine(
+	screen tcell.Screen,
+	r rect,
+	scale Scale,
+	spacing int,
+	values []DecimalValue,
+	runes []rune,
+	style tcell.Style,
+) {
+	if len(runes) < len(DefaultLinesRunes) {
+		runes = DefaultLinesRunes
+	}
+
+	horizontal, vertical := runes[0], runes[1]
+	downRight, downLeft := runes[2], runes[3]
+	upRight, upLeft := runes[4], runes[5]
+
+	setContent := func(xx, v int, ch rune) {
+		yy := r.y + r.h - v - 1
+		screen.SetContent(xx, yy, ch, nil, style)
+	}
+
+	prev := 0
+	prevValid := false
+
+	for i, value := range values {
+		if !value.Valid {
+			prevValid = false
+			continue
+		}
+
+		v := scale.Value(value.Decimal)
+		xx := r.x + i*spacing + (r.w - len(values)*spacing)
+
+		if v < 0 {
+			v = 0
+		}
+
+		if v >= r.h {
+			v = r.h - 1
+		}
+
+		switch {
+		case !prevValid || v == prev:
+			setContent(xx, v, horizontal)
+		case v > prev:
+			setContent(xx, prev, upLeft)
+			for j := prev + 1; j < v; j++ {
+				setContent(xx, j, vertical)
+			}
+			setContent(xx, v, downRight)
+		default:
+			setContent(xx, prev, downLeft)
+			for j := v + 1; j < prev; j++ {
+				setContent(xx, j, vertical)
+			}
+			setContent(xx, v, upRight)
+		}
+
+		for j := 1; j < spacing; j++ {
+			setContent(xx+j, v, horizontal)
+		}
+
+		prev = v
+		prevValid = true
+	}
+}
+
+func (b *Lines) drawBraille(screen tcell.Screen, data DataSource) {
+	x, y, _, _ := b.GetInnerRect()
+	canvas, scale := b.brailleCanvas(b.calcRange(data))
+	dotW, dotH := canvas.DotSize()
+
+	var prevX, prevY int
+
+	l := data.Len()
+
+	for i := 0; i < l; i++ {
+		dec := data.At(i)
+		xx := i*b.spacing + (dotW - l*b.spacing)
+		yy := dotH - scale.Value(dec) - 1
+
+		if i == 0 {
+			canvas.Point(xx, yy, b.style)
+		} else {
+			canvas.Line(prevX, prevY, xx, yy, b.style)
+		}
+
+		prevX, prevY = xx, yy
+	}
+
+	canvas.Draw(screen, x, y)
+}
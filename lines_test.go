@@ -0,0 +1,80 @@
+package tplot_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/jeremija/tplot"
+	"github.com/jeremija/tplot/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLines(t *testing.T) {
+	var factory tplot.FloatFactory
+
+	p := tplot.NewLines(factory)
+	scr := test.NewScreen()
+
+	vals := []int64{0, 1, 1, 5, 4, 0, 2}
+
+	data := make([]tplot.Decimal, len(vals))
+
+	for i, val := range vals {
+		data[i] = factory.NewFromInt64(val)
+	}
+
+	p.SetRect(0, 0, 14, 6)
+	p.SetSpacing(2)
+
+	p.SetData(data)
+	p.Draw(scr)
+
+	exp := `
+      ╭─╮
+      │ ╰─╮
+      │   │
+      │   │ ╭─
+  ╭───╯   │ │
+──╯       ╰─╯`
+
+	fmt.Println("== expected ==")
+	fmt.Println(exp)
+	fmt.Println("==  actual  ==")
+	fmt.Println(scr.Content())
+	fmt.Println("==============")
+
+	assert.Equal(t, exp, "\n"+scr.Content())
+}
+
+func TestLines_braille(t *testing.T) {
+	var factory tplot.FloatFactory
+
+	p := tplot.NewLines(factory)
+	scr := test.NewScreen()
+
+	vals := []int64{0, 1, 2, 3, 4, 5, 6, 7}
+
+	data := make([]tplot.Decimal, len(vals))
+
+	for i, val := range vals {
+		data[i] = factory.NewFromInt64(val)
+	}
+
+	p.SetRect(0, 0, 4, 2)
+	p.SetRenderMode(tplot.RenderBraille)
+
+	p.SetData(data)
+	p.Draw(scr)
+
+	exp := `
+  ⡠⠊
+⡠⠊`
+
+	fmt.Println("== expected ==")
+	fmt.Println(exp)
+	fmt.Println("==  actual  ==")
+	fmt.Println(scr.Content())
+	fmt.Println("==============")
+
+	assert.Equal(t, exp, "\n"+scr.Content())
+}
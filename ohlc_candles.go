@@ -100,6 +100,17 @@ func (o *OHLCCandles) SetData(data []OHLC) {
 	o.rng = o.calcRange(data)
 }
 
+// SetRange overrides the range calculated from the data in SetData. This is
+// useful when other components share the same scale, for example overlays.
+func (o *OHLCCandles) SetRange(rng Range) {
+	o.rng = rng
+}
+
+// Range returns the range of values used for scaling.
+func (o *OHLCCandles) Range() Range {
+	return o.rng
+}
+
 func (o *OHLCCandles) Draw(screen tcell.Screen) {
 	o.Box.DrawForSubclass(screen, o)
 
@@ -4,26 +4,72 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"sync"
+	"time"
 
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
 )
 
-// OHLCChart is a Box component that can render OHLCChart data.
+// OHLCChart is a Box component that can render OHLCChart data. The methods
+// of OHLCChart can be called from another goroutine while the chart is being
+// drawn, unlike the methods of the embedded tview.Box, and so can the methods
+// of the added panes. Overlays should not be changed after they were added to
+// the chart.
 type OHLCChart struct {
 	*tview.Box
 
 	factory DecimalFactory
 
-	ohlcCandles *OHLCCandles
-	ohlcAxis    *Axis
+	ohlcCandles   *OHLCCandles
+	ohlcAxis      *Axis
+	ohlcScaleType ScaleType
 
 	volumeBars *Bars
 	volumeAxis *Axis
 
-	items  []OHLC
-	offset int
-	logger io.Writer
+	timeAxis        *TimeAxis
+	timeAxisVisible bool
+
+	overlays []*Overlay
+	panes    []*OHLCPane
+
+	// mu guards the state of the chart, so that for example items can be
+	// added from another goroutine while the chart is being drawn.
+	mu sync.Mutex
+
+	// source contains the items set by SetItems, and items contains the
+	// source items resampled to the current timeframe.
+	source     []OHLC
+	maxItems   int
+	items      []OHLC
+	timeframe  time.Duration
+	timeframes []time.Duration
+	offset     int
+	logger     io.Writer
+
+	// overlayValues contains the values of the overlays for all items,
+	// which are only calculated again when the items change.
+	overlayValues map[*Overlay][]DecimalValue
+
+	// cursor is the index of the item selected by the cursor.
+	cursor         int
+	cursorVisible  bool
+	crosshairStyle tcell.Style
+
+	gridVisible bool
+	gridStyle   tcell.Style
+
+	hlines   []HLine
+	vmarkers []VMarker
+
+	// hover is the index of the item under the mouse pointer.
+	hover      int
+	hoverValid bool
+	drag       ohlcDrag
+
+	// view contains the layout calculated during the last Draw.
+	view ohlcView
 
 	// volumeHeightFraction is a number between 0 and 1 that determines how much
 	// of the layout should be taken by the OHLC chart.
@@ -43,15 +89,25 @@ func NewOHLCChart(factory DecimalFactory) *OHLCChart {
 		volumeBars: NewBars(factory),
 		volumeAxis: NewAxis(factory),
 
+		timeAxis:        NewTimeAxis(),
+		timeAxisVisible: true,
+
+		crosshairStyle: tcell.StyleDefault.Foreground(tcell.ColorGray),
+		gridStyle:      tcell.StyleDefault.Foreground(tcell.ColorDarkGray),
+
 		volumeHeightFraction: 0.2,
 	}
 
 	ohlc.SetVolumeBarsStyle(tcell.StyleDefault.Foreground(tcell.ColorDarkBlue))
+	ohlc.volumeAxis.SetFormatter(SIFormatter(2))
 
 	return ohlc
 }
 
 func (o *OHLCChart) SetVolumeHeight(fraction float64) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
 	if fraction < 0 {
 		fraction = 0
 	}
@@ -64,68 +120,367 @@ func (o *OHLCChart) SetVolumeHeight(fraction float64) {
 }
 
 func (o *OHLCChart) SetVolumeBarsRunes(runes []rune) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
 	o.volumeBars.SetRunes(runes)
 }
 
 func (o *OHLCChart) VolumeBarsRunes() []rune {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
 	return o.volumeBars.Runes()
 }
 
 func (o *OHLCChart) SetOHLCCandlesRunes(runes OHLCRunes) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
 	o.ohlcCandles.SetRunes(runes)
 }
 
 func (o *OHLCChart) OHLCCandlesRunes() OHLCRunes {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
 	return o.ohlcCandles.Runes()
 }
 
 func (o *OHLCChart) SetPositiveStyle(positiveStyle tcell.Style) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
 	o.ohlcCandles.SetPositiveStyle(positiveStyle)
 }
 
 func (o *OHLCChart) PositiveStyle() tcell.Style {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
 	return o.ohlcCandles.PositiveStyle()
 }
 
 func (o *OHLCChart) SetNegativeStyle(negativeStyle tcell.Style) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
 	o.ohlcCandles.SetNegativeStyle(negativeStyle)
 }
 
 func (o *OHLCChart) NegativeStyle() tcell.Style {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
 	return o.ohlcCandles.NegativeStyle()
 }
 
 func (o *OHLCChart) SetVolumeBarsStyle(style tcell.Style) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
 	o.volumeBars.SetStyle(style)
 }
 
 func (o *OHLCChart) VolumeBarsStyle() tcell.Style {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
 	return o.volumeBars.Style()
 }
 
 func (o *OHLCChart) SetVolumeAxisStyle(style tcell.Style) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
 	o.volumeAxis.SetStyle(style)
 }
 
 func (o *OHLCChart) VolumeAxisStyle() tcell.Style {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
 	return o.volumeAxis.Style()
 }
 
 func (o *OHLCChart) SetOHLCAxisStyle(style tcell.Style) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
 	o.ohlcAxis.SetStyle(style)
 }
 
 func (o *OHLCChart) OHLCAxisStyle() tcell.Style {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
 	return o.ohlcAxis.Style()
 }
 
+func (o *OHLCChart) SetTimeAxisStyle(style tcell.Style) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.timeAxis.SetStyle(style)
+}
+
+func (o *OHLCChart) TimeAxisStyle() tcell.Style {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return o.timeAxis.Style()
+}
+
+// SetTimeAxisVisible sets whether the time axis should be drawn below the
+// volume bars.
+func (o *OHLCChart) SetTimeAxisVisible(visible bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.timeAxisVisible = visible
+}
+
+// TimeAxisVisible returns true when the time axis is drawn.
+func (o *OHLCChart) TimeAxisVisible() bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return o.timeAxisVisible
+}
+
+// SetOHLCScaleType sets the type of the scale used for the OHLC candles, for
+// example ScaleTypeLog for charts spanning multiple orders of magnitude.
+func (o *OHLCChart) SetOHLCScaleType(scaleType ScaleType) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.ohlcScaleType = scaleType
+}
+
+// OHLCScaleType returns the type of the scale used for the OHLC candles.
+func (o *OHLCChart) OHLCScaleType() ScaleType {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return o.ohlcScaleType
+}
+
+// ToggleOHLCScaleType switches the OHLC candles between linear and
+// logarithmic scale.
+func (o *OHLCChart) ToggleOHLCScaleType() {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.toggleOHLCScaleType()
+}
+
+func (o *OHLCChart) toggleOHLCScaleType() {
+	if o.ohlcScaleType == ScaleTypeLog {
+		o.ohlcScaleType = ScaleTypeLinear
+	} else {
+		o.ohlcScaleType = ScaleTypeLog
+	}
+}
+
+// SetCrosshairStyle sets the style of the crosshair drawn at the cursor.
+func (o *OHLCChart) SetCrosshairStyle(style tcell.Style) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.crosshairStyle = style
+}
+
+// CrosshairStyle returns the style of the crosshair drawn at the cursor.
+func (o *OHLCChart) CrosshairStyle() tcell.Style {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return o.crosshairStyle
+}
+
+// SetOHLCAxisFormatter sets the formatter of the prices on the OHLC axis.
+func (o *OHLCChart) SetOHLCAxisFormatter(formatter Formatter) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.ohlcAxis.SetFormatter(formatter)
+}
+
+// OHLCAxisFormatter returns the formatter of the prices.
+func (o *OHLCChart) OHLCAxisFormatter() Formatter {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return o.ohlcAxis.Formatter()
+}
+
+// SetVolumeAxisFormatter sets the formatter of the volume on the volume axis.
+// SIFormatter is used by default.
+func (o *OHLCChart) SetVolumeAxisFormatter(formatter Formatter) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.volumeAxis.SetFormatter(formatter)
+}
+
+// VolumeAxisFormatter returns the formatter of the volume.
+func (o *OHLCChart) VolumeAxisFormatter() Formatter {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return o.volumeAxis.Formatter()
+}
+
+// SetGridVisible shows or hides the horizontal gridlines at the ticks of the
+// OHLC and pane axes. The gridlines are drawn only over empty cells.
+func (o *OHLCChart) SetGridVisible(visible bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.gridVisible = visible
+}
+
+// GridVisible returns true when the gridlines are shown.
+func (o *OHLCChart) GridVisible() bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return o.gridVisible
+}
+
+// SetGridStyle sets the style of the gridlines.
+func (o *OHLCChart) SetGridStyle(style tcell.Style) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.gridStyle = style
+}
+
+// GridStyle returns the style of the gridlines.
+func (o *OHLCChart) GridStyle() tcell.Style {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return o.gridStyle
+}
+
+// SetHLines sets the horizontal lines drawn beneath the OHLC candles, for
+// example at support and resistance prices. The values of the lines are
+// included in the range and highlighted on the OHLC axis.
+func (o *OHLCChart) SetHLines(lines []HLine) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.hlines = lines
+}
+
+// HLines returns the horizontal lines.
+func (o *OHLCChart) HLines() []HLine {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return o.hlines
+}
+
+// SetVMarkers sets the vertical markers drawn beneath the candles, the
+// volume bars and the panes. A marker with Time set marks the item with the
+// timestamp in the current timeframe, otherwise the item at Index.
+func (o *OHLCChart) SetVMarkers(markers []VMarker) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.vmarkers = markers
+}
+
+// VMarkers returns the vertical markers.
+func (o *OHLCChart) VMarkers() []VMarker {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return o.vmarkers
+}
+
+// AddOverlay adds an overlay drawn over the OHLC candles. The values of the
+// overlay are calculated again only when the items change.
+func (o *OHLCChart) AddOverlay(overlay *Overlay) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.overlays = append(o.overlays, overlay)
+}
+
+// RemoveOverlay removes a previously added overlay.
+func (o *OHLCChart) RemoveOverlay(overlay *Overlay) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	for i, ov := range o.overlays {
+		if ov == overlay {
+			o.overlays = append(o.overlays[:i:i], o.overlays[i+1:]...)
+			delete(o.overlayValues, overlay)
+
+			return
+		}
+	}
+}
+
+// Overlays returns the overlays drawn over the OHLC candles.
+func (o *OHLCChart) Overlays() []*Overlay {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return o.overlays
+}
+
+// AddPane adds a pane below the volume bars. A pane can only be added to a
+// single chart.
+func (o *OHLCChart) AddPane(pane *OHLCPane) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	pane.chart = o
+	o.panes = append(o.panes, pane)
+}
+
+// RemovePane removes a previously added pane.
+func (o *OHLCChart) RemovePane(pane *OHLCPane) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	for i, p := range o.panes {
+		if p == pane {
+			o.panes = append(o.panes[:i:i], o.panes[i+1:]...)
+
+			for _, overlay := range pane.overlays {
+				delete(o.overlayValues, overlay)
+			}
+
+			return
+		}
+	}
+}
+
+// Panes returns the panes drawn below the volume bars.
+func (o *OHLCChart) Panes() []*OHLCPane {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return o.panes
+}
+
 // SetLogger sets the logger for debugging.
 func (o *OHLCChart) SetLogger(w io.Writer) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
 	o.logger = w
 }
 
 // Logger returns the current logger set. Used for debugging.
 func (o *OHLCChart) Logger() io.Writer {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
 	if w := o.logger; w != nil {
 		return w
 	}
@@ -135,12 +490,29 @@ func (o *OHLCChart) Logger() io.Writer {
 
 // Offset returns the current offset.
 func (o *OHLCChart) Offset() int {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
 	return o.offset
 }
 
 // SetOffset sets the scroll offset for OHLC data. It ensures it's always less
 // than the size of the items and is never negative.
 func (o *OHLCChart) SetOffset(offset int) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.setOffset(offset)
+}
+
+func (o *OHLCChart) AddOffset(delta int) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.setOffset(o.offset + delta)
+}
+
+func (o *OHLCChart) setOffset(offset int) {
 	if l := len(o.items); offset >= l {
 		offset = l - 1
 	}
@@ -149,68 +521,457 @@ func (o *OHLCChart) SetOffset(offset int) {
 		offset = 0
 	}
 
+	// A different item is under the pointer now, which is only known after
+	// the next Draw.
+	if offset != o.offset {
+		o.hoverValid = false
+	}
+
 	o.offset = offset
 }
 
-func (o *OHLCChart) AddOffset(delta int) {
-	o.SetOffset(o.offset + delta)
+// SetCursorVisible shows or hides the cursor. When the cursor is shown, its
+// item is displayed in the title instead of the last visible item. When the
+// cursor is shown and its item is not visible, it is placed at the last
+// visible item.
+func (o *OHLCChart) SetCursorVisible(visible bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.setCursorVisible(visible)
 }
 
-// SetItems sets the OHLC data.
+func (o *OHLCChart) setCursorVisible(visible bool) {
+	if visible && !o.cursorVisible {
+		if o.cursor < o.view.start || o.cursor >= o.view.end {
+			o.cursor = o.view.end - 1
+		}
+
+		o.setCursor(o.cursor)
+	}
+
+	o.cursorVisible = visible
+}
+
+// CursorVisible returns true when the cursor is shown.
+func (o *OHLCChart) CursorVisible() bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return o.cursorVisible
+}
+
+// SetCursor sets the cursor to the item at index i. It ensures the cursor
+// is within items and scrolls the chart so that the item is visible.
+func (o *OHLCChart) SetCursor(i int) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.setCursor(i)
+}
+
+func (o *OHLCChart) setCursor(i int) {
+	if l := len(o.items); i >= l {
+		i = l - 1
+	}
+
+	if i < 0 {
+		i = 0
+	}
+
+	o.cursor = i
+	// The cursor is displayed instead of the hovered item until the pointer
+	// moves again.
+	o.hoverValid = false
+
+	// The view is unknown before the first Draw.
+	if o.view.end == 0 {
+		return
+	}
+
+	if i < o.view.start {
+		o.setOffset(o.offset + o.view.start - i)
+	}
+
+	if i >= o.view.end {
+		o.setOffset(o.offset + o.view.end - i - 1)
+	}
+}
+
+// Cursor returns the index of the item selected by the cursor.
+func (o *OHLCChart) Cursor() int {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return o.cursor
+}
+
+// MoveCursor moves the cursor by delta items.
+func (o *OHLCChart) MoveCursor(delta int) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.setCursor(o.cursor + delta)
+}
+
+// itemAt returns the index of the item drawn at column x during the last
+// Draw.
+func (o *OHLCChart) itemAt(x int) (int, bool) {
+	v := o.view
+
+	if x < v.x || x >= v.x+v.w {
+		return 0, false
+	}
+
+	i := v.end - 1 - (v.x+v.w-1-x)/v.spacing
+
+	if i < v.start || i >= v.end {
+		return 0, false
+	}
+
+	return i, true
+}
+
+// SetItems sets the OHLC data. The items are copied and resampled when a
+// timeframe is set.
 func (o *OHLCChart) SetItems(items []OHLC) {
-	o.items = items
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.source = append([]OHLC(nil), items...)
+	o.items = ResampleOHLC(o.source, o.timeframe)
+	o.overlayValues = nil
+	o.trim()
 }
 
-// Items returns the current OHLC data.
+// Items returns a copy of the current OHLC data, as set by SetItems, Append
+// and UpdateLast.
 func (o *OHLCChart) Items() []OHLC {
-	return o.items
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return append([]OHLC(nil), o.source...)
+}
+
+// Append adds item after the last item. It is safe to call Append from
+// another goroutine while the chart is being drawn. When the offset is zero
+// the chart keeps showing the latest items, otherwise the visible items
+// remain the same.
+func (o *OHLCChart) Append(item OHLC) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.append(item)
+}
+
+func (o *OHLCChart) append(item OHLC) {
+	l := len(o.items)
+
+	o.source = append(o.source, item)
+
+	if o.timeframe > 0 {
+		o.items = resampleAppend(o.items, item, o.timeframe)
+	} else {
+		o.items = o.source
+	}
+
+	if o.offset > 0 {
+		o.offset += len(o.items) - l
+	}
+
+	o.overlayValues = nil
+	o.trim()
+}
+
+// UpdateLast replaces the last item, for example when the latest candle is
+// still being formed. It behaves like Append when there are no items. It is
+// safe to call UpdateLast from another goroutine while the chart is being
+// drawn.
+func (o *OHLCChart) UpdateLast(item OHLC) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	l := len(o.source)
+	if l == 0 {
+		o.append(item)
+		return
+	}
+
+	o.source[l-1] = item
+	o.overlayValues = nil
+
+	if o.timeframe <= 0 {
+		return
+	}
+
+	// Rebuild the last bucket from the source items. The updated item might
+	// also start a new bucket.
+	last := len(o.items) - 1
+	start := searchOHLCFrom(o.source, o.items[last].Timestamp)
+
+	o.items = o.items[:last]
+
+	for _, item := range o.source[start:] {
+		o.items = resampleAppend(o.items, item, o.timeframe)
+	}
+
+	if o.offset > 0 {
+		o.offset += len(o.items) - last - 1
+	}
+}
+
+// SetMaxItems sets the maximum number of items to keep. The oldest items are
+// removed when there are more. Zero means there is no limit.
+func (o *OHLCChart) SetMaxItems(maxItems int) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.maxItems = maxItems
+	o.trim()
+}
+
+// MaxItems returns the maximum number of items to keep.
+func (o *OHLCChart) MaxItems() int {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return o.maxItems
+}
+
+// trim removes the oldest items when there are more than maxItems and
+// adjusts the indexes that refer to the displayed items.
+func (o *OHLCChart) trim() {
+	n := len(o.source) - o.maxItems
+	if o.maxItems <= 0 || n <= 0 {
+		return
+	}
+
+	l := len(o.items)
+
+	o.source = o.source[n:]
+	o.overlayValues = nil
+
+	if o.timeframe > 0 {
+		// The first bucket might have lost some of its items so it is
+		// rebuilt from the remaining source items.
+		first := truncateTime(o.source[0].Timestamp, o.timeframe)
+		end := searchOHLCFrom(o.source, first.Add(o.timeframe))
+
+		o.items = o.items[searchOHLCFrom(o.items, first):]
+		o.items[0] = ResampleOHLC(o.source[:end], o.timeframe)[0]
+	} else {
+		o.items = o.source
+	}
+
+	removed := l - len(o.items)
+
+	o.cursor -= removed
+	if o.cursor < 0 {
+		o.cursor = 0
+	}
+
+	o.hover -= removed
+	if o.hover < 0 {
+		o.hoverValid = false
+	}
+
+	o.setOffset(o.offset)
+}
+
+// SetTimeframes sets the timeframes that can be cycled through using
+// NextTimeframe and PrevTimeframe. A zero timeframe represents the items as
+// they were set by SetItems.
+func (o *OHLCChart) SetTimeframes(timeframes []time.Duration) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.timeframes = timeframes
+}
+
+// Timeframes returns the timeframes that can be cycled through.
+func (o *OHLCChart) Timeframes() []time.Duration {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return o.timeframes
+}
+
+// SetTimeframe resamples the items to timeframe, see ResampleOHLC. The
+// cursor and the right edge of the chart are kept at the same point in time.
+func (o *OHLCChart) SetTimeframe(timeframe time.Duration) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.setTimeframe(timeframe)
+}
+
+func (o *OHLCChart) setTimeframe(timeframe time.Duration) {
+	if timeframe == o.timeframe {
+		return
+	}
+
+	// The right edge is anchored to the beginning of the first item after
+	// it, so that it contains the whole bucket when switching to a shorter
+	// timeframe.
+	var next, cursor time.Time
+
+	hasNext := false
+
+	if l := len(o.items); l > 0 {
+		if end := l - o.offset; end < l {
+			next = o.items[end].Timestamp
+			hasNext = true
+		}
+
+		if o.cursor >= 0 && o.cursor < l {
+			cursor = o.items[o.cursor].Timestamp
+		}
+	}
+
+	o.timeframe = timeframe
+	o.items = ResampleOHLC(o.source, timeframe)
+	o.overlayValues = nil
+	o.hoverValid = false
+
+	if len(o.items) == 0 {
+		return
+	}
+
+	o.cursor = searchOHLC(o.items, cursor)
+
+	if !hasNext {
+		o.setOffset(0)
+		return
+	}
+
+	o.setOffset(len(o.items) - searchOHLCFrom(o.items, next))
+}
+
+// Timeframe returns the current timeframe. A zero timeframe means the items
+// are displayed as they were set.
+func (o *OHLCChart) Timeframe() time.Duration {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return o.timeframe
+}
+
+// NextTimeframe switches to the next timeframe from Timeframes.
+func (o *OHLCChart) NextTimeframe() {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.cycleTimeframe(1)
+}
+
+// PrevTimeframe switches to the previous timeframe from Timeframes.
+func (o *OHLCChart) PrevTimeframe() {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.cycleTimeframe(-1)
+}
+
+func (o *OHLCChart) cycleTimeframe(delta int) {
+	l := len(o.timeframes)
+	if l == 0 {
+		return
+	}
+
+	i := 0
+
+	for j, timeframe := range o.timeframes {
+		if timeframe == o.timeframe {
+			i = (j + delta + l) % l
+			break
+		}
+	}
+
+	o.setTimeframe(o.timeframes[i])
 }
 
 // SetSpacing sets the chart spacing.
 func (o *OHLCChart) SetSpacing(spacing int) {
-	o.ohlcCandles.SetSpacing(spacing)
-	o.volumeBars.SetSpacing(spacing)
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.setSpacing(spacing)
 }
 
 func (o *OHLCChart) AddSpacing(delta int) {
-	o.ohlcCandles.SetSpacing(o.ohlcCandles.Spacing() + delta)
-	o.volumeBars.SetSpacing(o.volumeBars.Spacing() + delta)
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.setSpacing(o.spacing() + delta)
+}
+
+func (o *OHLCChart) setSpacing(spacing int) {
+	o.ohlcCandles.SetSpacing(spacing)
+	o.volumeBars.SetSpacing(spacing)
+
+	// A different item is under the pointer now, which is only known after
+	// the next Draw.
+	o.hoverValid = false
 }
 
 // Spacing returns the current spacing.
 func (o *OHLCChart) Spacing() int {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return o.spacing()
+}
+
+func (o *OHLCChart) spacing() int {
 	return o.ohlcCandles.Spacing()
 }
 
 // MouseHandler implements tview.Primitive.
 func (o *OHLCChart) InputHandler() func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
 	moveHome := func() {
-		s := len(o.Items())
-		o.SetOffset(s - 1)
+		s := len(o.items)
+		o.setOffset(s - 1)
 	}
 
 	moveEnd := func() {
-		o.SetOffset(0)
+		o.setOffset(0)
 	}
 
 	moveLeft := func() {
-		o.AddOffset(1)
+		if o.cursorVisible {
+			o.setCursor(o.cursor - 1)
+			return
+		}
+
+		o.setOffset(o.offset + 1)
 	}
 
 	moveRight := func() {
-		o.AddOffset(-1)
+		if o.cursorVisible {
+			o.setCursor(o.cursor + 1)
+			return
+		}
+
+		o.setOffset(o.offset - 1)
 	}
 
 	moveLeftLong := func() {
-		o.SetOffset(o.offset + 20)
+		o.setOffset(o.offset + 20)
 	}
 
 	moveRightLong := func() {
-		o.SetOffset(o.offset - 20)
+		o.setOffset(o.offset - 20)
 	}
 
 	return o.WrapInputHandler(
 		func(event *tcell.EventKey, setFocus func(p tview.Primitive)) {
+			o.mu.Lock()
+			defer o.mu.Unlock()
+
+			// The keys move the chart or the cursor, which is displayed
+			// instead of the hovered item until the pointer moves again.
+			o.hoverValid = false
+
 			switch event.Key() {
 			case tcell.KeyEnd:
 				moveEnd()
@@ -224,6 +985,8 @@ func (o *OHLCChart) InputHandler() func(event *tcell.EventKey, setFocus func(p t
 				moveLeft()
 			case tcell.KeyRight:
 				moveRight()
+			case tcell.KeyEscape:
+				o.setCursorVisible(false)
 
 			case tcell.KeyRune:
 				if event.Modifiers()&tcell.ModAlt > 0 {
@@ -239,11 +1002,19 @@ func (o *OHLCChart) InputHandler() func(event *tcell.EventKey, setFocus func(p t
 
 				switch event.Rune() {
 				case '0':
-					o.SetSpacing(1)
+					o.setSpacing(1)
+				case 's':
+					o.toggleOHLCScaleType()
+				case 'c':
+					o.setCursorVisible(!o.cursorVisible)
+				case 't':
+					o.cycleTimeframe(1)
+				case 'T':
+					o.cycleTimeframe(-1)
 				case '=':
-					o.AddSpacing(1)
+					o.setSpacing(o.spacing() + 1)
 				case '-':
-					o.AddSpacing(-1)
+					o.setSpacing(o.spacing() - 1)
 				case 'b':
 					moveLeftLong()
 				case 'w', 'e':
@@ -262,16 +1033,70 @@ func (o *OHLCChart) InputHandler() func(event *tcell.EventKey, setFocus func(p t
 	)
 }
 
-// MouseHandler implements tview.Primitive.
+// MouseHandler implements tview.Primitive. Left click selects the item
+// under the pointer, dragging pans the chart and the wheel scrolls it. The
+// wheel zooms around the pointer when Ctrl is pressed.
 func (o *OHLCChart) MouseHandler() func(action tview.MouseAction, event *tcell.EventMouse, setFocus func(p tview.Primitive)) (consumed bool, capture tview.Primitive) {
 	return o.Box.WrapMouseHandler(func(action tview.MouseAction, ev *tcell.EventMouse, setFocus func(p tview.Primitive)) (bool, tview.Primitive) {
+		o.mu.Lock()
+		defer o.mu.Unlock()
+
+		x, y := ev.Position()
+
+		if o.drag.active {
+			switch action {
+			case tview.MouseMove:
+				o.dragTo(x)
+
+				return true, o
+			case tview.MouseLeftUp:
+				o.drag.active = false
+
+				return true, nil
+			}
+		}
+
+		if !o.InRect(x, y) {
+			o.hoverValid = false
+
+			return false, nil
+		}
+
 		switch action {
+		case tview.MouseMove:
+			o.hover, o.hoverValid = o.itemAt(x)
+
+			return true, nil
+		case tview.MouseLeftDown:
+			setFocus(o)
+
+			o.drag = ohlcDrag{
+				active: true,
+				x:      x,
+			}
+
+			return true, o
+		case tview.MouseLeftClick:
+			if i, ok := o.itemAt(x); ok {
+				o.setCursor(i)
+				o.setCursorVisible(true)
+			}
+
+			return true, nil
 		case tview.MouseScrollUp:
-			o.AddOffset(10)
+			if ev.Modifiers()&tcell.ModCtrl > 0 {
+				o.zoomAt(x, 1)
+			} else {
+				o.setOffset(o.offset + 10)
+			}
 
 			return true, o
 		case tview.MouseScrollDown:
-			o.AddOffset(-10)
+			if ev.Modifiers()&tcell.ModCtrl > 0 {
+				o.zoomAt(x, -1)
+			} else {
+				o.setOffset(o.offset - 10)
+			}
 
 			return true, o
 		}
@@ -280,43 +1105,109 @@ func (o *OHLCChart) MouseHandler() func(action tview.MouseAction, event *tcell.E
 	})
 }
 
-func (o *OHLCChart) volSize() int {
-	_, _, _, h := o.GetInnerRect()
+// dragTo pans the chart by the number of items the pointer was dragged over
+// since the last call.
+func (o *OHLCChart) dragTo(x int) {
+	spacing := o.spacing()
 
-	v := int(math.Floor(float64(h) * o.volumeHeightFraction))
-
-	if v < 0 {
-		return 0
+	if delta := (x - o.drag.x) / spacing; delta != 0 {
+		o.setOffset(o.offset + delta)
+		o.drag.x += delta * spacing
 	}
+}
+
+// zoomAt changes the spacing by delta while keeping the item at column x in
+// place.
+func (o *OHLCChart) zoomAt(x int, delta int) {
+	i, ok := o.itemAt(x)
 
-	if v > h {
-		return h
+	o.setSpacing(o.spacing() + delta)
+
+	if !ok {
+		return
 	}
 
-	return v
+	v := o.view
+	// right is the number of items that will fit right of the item i.
+	right := (v.x + v.w - 1 - x) / o.spacing()
+
+	o.setOffset(len(o.items) - 1 - i - right)
+}
+
+// ohlcLayout contains the rects of all parts of the chart.
+type ohlcLayout struct {
+	ohlc   rect
+	volume rect
+	panes  []rect
+	time   rect
 }
 
-func (o *OHLCChart) ohlcRect() rect {
+// layout calculates the rects of all parts of the chart. The candles take the
+// height that is left after the volume bars, the panes and the time axis.
+func (o *OHLCChart) layout() ohlcLayout {
 	x, y, w, h := o.GetInnerRect()
 
-	h -= o.volSize()
+	if h < 0 {
+		h = 0
+	}
 
-	return rect{x: x, y: y, w: w, h: h}
-}
+	timeSize := 0
 
-func (o *OHLCChart) volRect() rect {
-	x, y, w, _ := o.GetInnerRect()
-	ohlcRect := o.ohlcRect()
+	if o.timeAxisVisible && h > 0 {
+		timeSize = 1
+	}
 
-	volSize := o.volSize()
+	// available is the height shared by the candles, volume bars and panes.
+	available := h - timeSize
+	remaining := available
 
-	h := volSize
-	y += ohlcRect.h
+	take := func(fraction float64) int {
+		v := int(math.Floor(float64(available) * fraction))
+
+		if v < 0 {
+			v = 0
+		}
+
+		if v > remaining {
+			v = remaining
+		}
+
+		remaining -= v
+
+		return v
+	}
 
-	return rect{x: x, y: y, w: w, h: h}
+	volSize := take(o.volumeHeightFraction)
+
+	paneSizes := make([]int, len(o.panes))
+
+	for i, pane := range o.panes {
+		paneSizes[i] = take(pane.heightFraction)
+	}
+
+	var l ohlcLayout
+
+	l.ohlc = rect{x: x, y: y, w: w, h: remaining}
+	y += remaining
+
+	l.volume = rect{x: x, y: y, w: w, h: volSize}
+	y += volSize
+
+	l.panes = make([]rect, len(o.panes))
+
+	for i, size := range paneSizes {
+		l.panes[i] = rect{x: x, y: y, w: w, h: size}
+		y += size
+	}
+
+	l.time = rect{x: x, y: y, w: w, h: timeSize}
+
+	return l
 }
 
-func (o *OHLCChart) ohlcRange(items []OHLC) Range {
+// ohlcRange returns the range of the items and the overlay values for the
+// same items. The first item is at index start in the overlay values.
+func (o *OHLCChart) ohlcRange(items []OHLC, overlayValues [][]DecimalValue, start int) Range {
 	rng := NewRange(o.factory)
 
 	for _, ohlc := range items {
@@ -324,11 +1215,107 @@ func (o *OHLCChart) ohlcRange(items []OHLC) Range {
 		rng = rng.Feed(ohlc.H)
 	}
 
+	for _, values := range overlayValues {
+		for _, value := range values[start : start+len(items)] {
+			if value.Valid {
+				rng = rng.Feed(value.Decimal)
+			}
+		}
+	}
+
+	for _, value := range hlineValues(o.hlines) {
+		rng = rng.Feed(value)
+	}
+
 	return rng
 }
 
+// markerIndex returns the index of the item marked by marker, or false when
+// the marker is before the first item.
+func (o *OHLCChart) markerIndex(marker VMarker) (int, bool) {
+	if marker.Time.IsZero() {
+		return marker.Index, true
+	}
+
+	if len(o.items) == 0 || marker.Time.Before(o.items[0].Timestamp) {
+		return 0, false
+	}
+
+	return searchOHLC(o.items, marker.Time), true
+}
+
+// drawAnnotations draws the vertical markers of the visible items from r.y to
+// bottom, and the horizontal lines in the OHLC rect r. The visible items are
+// taken from the view.
+func (o *OHLCChart) drawAnnotations(screen tcell.Screen, r rect, bottom int, scale Scale) {
+	start, end, spacing := o.view.start, o.view.end, o.view.spacing
+	l := end - start
+
+	for _, marker := range o.vmarkers {
+		i, ok := o.markerIndex(marker)
+		if !ok || i < start || i >= end {
+			continue
+		}
+
+		xx := r.x + (i-start)*spacing + (r.w - l*spacing)
+
+		drawVMarker(screen, rect{x: r.x, y: r.y, w: r.w, h: bottom - r.y}, xx, marker)
+	}
+
+	for _, line := range o.hlines {
+		if line.Value == nil {
+			continue
+		}
+
+		if row := scale.Value(line.Value); row >= 0 && row < r.h {
+			drawHLine(screen, r, r.y+r.h-row-1, line)
+		}
+	}
+}
+
+// calcOverlays calculates the values of all overlays for all items.
+func (o *OHLCChart) calcOverlays() [][]DecimalValue {
+	ret := make([][]DecimalValue, len(o.overlays))
+
+	for i, overlay := range o.overlays {
+		ret[i] = o.calcOverlay(overlay)
+	}
+
+	return ret
+}
+
+// calcOverlay returns the values of overlay for all items, calculating them
+// only when the items have changed since the last call. The values are
+// aligned with the last items when the indicator returns a different number
+// of values than items, and the items without a value get invalid values.
+func (o *OHLCChart) calcOverlay(overlay *Overlay) []DecimalValue {
+	if values, ok := o.overlayValues[overlay]; ok {
+		return values
+	}
+
+	values := overlay.indicator.Calculate(o.items)
+
+	if l := len(o.items); len(values) > l {
+		values = values[len(values)-l:]
+	} else if len(values) < l {
+		padded := make([]DecimalValue, l)
+		copy(padded[l-len(values):], values)
+		values = padded
+	}
+
+	if o.overlayValues == nil {
+		o.overlayValues = make(map[*Overlay][]DecimalValue)
+	}
+
+	o.overlayValues[overlay] = values
+
+	return values
+}
+
+// volumeRange returns the range of the volume, which always includes zero
+// because the volume bars grow from zero.
 func (o *OHLCChart) volumeRange(items []OHLC) Range {
-	rng := NewRange(o.factory)
+	rng := NewRange(o.factory).Feed(o.factory.Zero())
 
 	for _, ohlc := range items {
 		rng = rng.Feed(ohlc.V)
@@ -339,29 +1326,31 @@ func (o *OHLCChart) volumeRange(items []OHLC) Range {
 
 // Draw implements tview.Primitive.
 func (o *OHLCChart) Draw(screen tcell.Screen) {
-	ohlcRect := o.ohlcRect()
-	volRect := o.volRect()
-	ohlcScale := NewScaleLinear(o.factory)
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	layout := o.layout()
+	ohlcRect := layout.ohlc
+	volRect := layout.volume
+	timeRect := layout.time
+	ohlcScale := NewScale(o.factory, o.ohlcScaleType)
 	volScale := NewScaleLinear(o.factory)
-	spacing := o.Spacing()
-	items := o.Items()
-	offset := o.Offset()
-
-	if l := len(items); offset > l {
-		items = nil
-	} else {
-		items = items[:l-offset]
+	spacing := o.spacing()
+	items := o.items
+	offset := o.offset
+
+	// end is the index of the item after the last visible item.
+	end := len(items) - offset
+	if end < 0 {
+		end = 0
 	}
 
-	if l := len(items); l > 0 {
-		ohlc := items[l-1]
+	items = items[:end]
 
-		title := fmt.Sprintf(" O=%s H=%s L=%s C=%s V=%s TS=%s ", ohlc.O, ohlc.H, ohlc.L, ohlc.C, ohlc.V, ohlc.Timestamp.Format("2006-01-02T15:04:05"))
+	overlayValues := o.calcOverlays()
+	panes := o.calcPanes(layout.panes)
 
-		o.SetTitle(title)
-	}
-
-	o.DrawForSubclass(screen, o)
+	selected := o.selectedIndex(end)
 
 	width := ohlcRect.w
 
@@ -374,29 +1363,69 @@ func (o *OHLCChart) Draw(screen tcell.Screen) {
 	ohlcScale.SetSize(ohlcRect.h)
 	volScale.SetSize(volRect.h)
 
-	ohlcRange := o.ohlcRange(items)
-	ohlcScale.SetRange(ohlcRange)
+	setRanges := func() {
+		start := end - len(items)
 
-	volRange := o.volumeRange(items)
-	volScale.SetRange(volRange)
+		ohlcScale.SetRange(o.ohlcRange(items, overlayValues, start))
+		volScale.SetRange(o.volumeRange(items))
+
+		for _, pane := range panes {
+			pane.scale.SetRange(pane.calcRange(o.factory, start, len(items)))
+		}
+	}
+
+	setRanges()
 
 	o.ohlcAxis.SetScale(ohlcScale)
 	o.ohlcAxis.SetStyle(tcell.StyleDefault.Foreground(tcell.ColorDarkCyan))
+	o.ohlcAxis.SetHLines(o.hlines)
 
 	o.volumeAxis.SetScale(volScale)
 	o.volumeAxis.SetStyle(tcell.StyleDefault.Foreground(tcell.ColorDarkBlue))
 
+	for _, pane := range panes {
+		pane.pane.axis.SetScale(pane.scale)
+	}
+
+	// The highlights are set before calculating the axis widths because
+	// the widths include the room for the highlighted values.
+	lastC := DecimalValue{}
+	lastV := DecimalValue{}
+
+	if selected >= 0 {
+		lastC.Decimal = o.items[selected].C
+		lastC.Valid = true
+		lastV.Decimal = o.items[selected].V
+		lastV.Valid = true
+	}
+
+	o.ohlcAxis.SetHighlight(lastC)
+	o.volumeAxis.SetHighlight(lastV)
+
+	for _, pane := range panes {
+		highlight := DecimalValue{}
+
+		if i := pane.pane.highlightIndex(); selected >= 0 && i >= 0 {
+			highlight = pane.values[i][selected]
+		}
+
+		pane.pane.axis.SetHighlight(highlight)
+	}
+
 	drawYAxis := true
 	axisYWidth := 0
 
 	if len(items) > 0 {
-		w1 := o.ohlcAxis.CalcWidth()
-		w2 := o.volumeAxis.CalcWidth()
+		axisYWidth = o.ohlcAxis.CalcWidth()
 
-		if w2 > w1 {
-			axisYWidth = w2
-		} else {
-			axisYWidth = w1
+		if w := o.volumeAxis.CalcWidth(); w > axisYWidth {
+			axisYWidth = w
+		}
+
+		for _, pane := range panes {
+			if w := pane.pane.axis.CalcWidth(); w > axisYWidth {
+				axisYWidth = w
+			}
 		}
 
 		widthWithoutYAxis := width - axisYWidth
@@ -409,6 +1438,11 @@ func (o *OHLCChart) Draw(screen tcell.Screen) {
 			o.ohlcAxis.SetRect(ohlcRect.x+width, ohlcRect.y, axisYWidth, ohlcRect.h)
 			o.volumeAxis.SetRect(volRect.x+width, volRect.y, axisYWidth, volRect.h)
 
+			for _, pane := range panes {
+				r := pane.rect
+				pane.pane.axis.SetRect(r.x+width, r.y, axisYWidth, r.h)
+			}
+
 			// We need to readjust the maxCount after taking account the axis width.
 			maxCount = width / spacing
 
@@ -417,37 +1451,43 @@ func (o *OHLCChart) Draw(screen tcell.Screen) {
 			if l := len(items); l > maxCount {
 				items = items[l-maxCount:]
 
-				ohlcRange = o.ohlcRange(items)
-				ohlcScale.SetRange(ohlcRange)
-
-				volRange = o.volumeRange(items)
-				volScale.SetRange(volRange)
+				setRanges()
 			}
 		}
 	}
 
-	var lastItem *OHLC
+	if selected >= 0 {
+		ohlc := o.items[selected]
+
+		// The values are shown exactly, unlike on the axes, which round
+		// them.
+		title := fmt.Sprintf(" O=%s H=%s L=%s C=%s V=%s TS=%s ",
+			ohlc.O, ohlc.H, ohlc.L, ohlc.C, ohlc.V,
+			ohlc.Timestamp.Format("2006-01-02T15:04:05"),
+		)
 
-	if l := len(items); l > 0 {
-		lastItem = &items[l-1]
+		o.SetTitle(title)
 	}
 
-	if len(items) > 0 && drawYAxis {
-		lastC := DecimalValue{}
-		lastV := DecimalValue{}
-
-		if lastItem != nil {
-			lastC.Decimal = lastItem.C
-			lastC.Valid = true
-			lastV.Decimal = lastItem.V
-			lastV.Valid = true
-		}
+	o.DrawForSubclass(screen, o)
 
-		o.ohlcAxis.SetHighlight(lastC)
-		o.ohlcAxis.Draw(screen)
+	start := end - len(items)
+
+	o.view = ohlcView{
+		start:   start,
+		end:     end,
+		x:       ohlcRect.x,
+		w:       width,
+		spacing: spacing,
+	}
 
-		o.volumeAxis.SetHighlight(lastV)
+	if len(items) > 0 && drawYAxis {
+		o.ohlcAxis.Draw(screen)
 		o.volumeAxis.Draw(screen)
+
+		for _, pane := range panes {
+			pane.pane.axis.Draw(screen)
+		}
 	}
 
 	if width < 0 {
@@ -457,8 +1497,15 @@ func (o *OHLCChart) Draw(screen tcell.Screen) {
 	o.ohlcCandles.SetRect(ohlcRect.x, ohlcRect.y, width, ohlcRect.h)
 	o.ohlcCandles.SetScale(ohlcScale)
 	o.ohlcCandles.SetData(items)
+	o.ohlcCandles.SetRange(ohlcScale.Range())
 	o.ohlcCandles.Draw(screen)
 
+	for i, overlay := range o.overlays {
+		r := rect{x: ohlcRect.x, y: ohlcRect.y, w: width, h: ohlcRect.h}
+
+		overlay.draw(screen, o.factory, r, ohlcScale, spacing, overlayValues[i][start:end])
+	}
+
 	volValues := make([]Decimal, len(items))
 
 	for i, item := range items {
@@ -469,6 +1516,147 @@ func (o *OHLCChart) Draw(screen tcell.Screen) {
 	o.volumeBars.SetScale(volScale)
 	o.volumeBars.SetData(volValues)
 	o.volumeBars.Draw(screen)
+
+	for _, pane := range panes {
+		r := pane.rect
+		r.w = width
+
+		for i, overlay := range pane.pane.overlays {
+			overlay.draw(screen, o.factory, r, pane.scale, spacing, pane.values[i][start:end])
+		}
+	}
+
+	if o.cursorVisible && o.cursor >= start && o.cursor < end {
+		xx := ohlcRect.x + (o.cursor-start)*spacing + (width - len(items)*spacing)
+		yy := ohlcRect.y + ohlcRect.h - ohlcScale.Value(o.items[o.cursor].C) - 1
+
+		o.drawCrosshair(screen, xx, yy, rect{
+			x: ohlcRect.x,
+			y: ohlcRect.y,
+			w: width,
+			h: timeRect.y - ohlcRect.y,
+		})
+	}
+
+	o.drawAnnotations(screen, rect{x: ohlcRect.x, y: ohlcRect.y, w: width, h: ohlcRect.h}, timeRect.y, ohlcScale)
+
+	if o.gridVisible && drawYAxis {
+		o.drawGrid(screen, rect{x: ohlcRect.x, y: ohlcRect.y, w: width, h: ohlcRect.h}, o.ohlcAxis)
+
+		for _, pane := range panes {
+			r := pane.rect
+			r.w = width
+
+			o.drawGrid(screen, r, pane.pane.axis)
+		}
+	}
+
+	if timeRect.h > 0 {
+		timestamps := make([]time.Time, len(items))
+
+		for i, item := range items {
+			timestamps[i] = item.Timestamp
+		}
+
+		o.timeAxis.SetRect(timeRect.x, timeRect.y, width, timeRect.h)
+		o.timeAxis.SetSpacing(spacing)
+		o.timeAxis.SetData(timestamps)
+		o.timeAxis.Draw(screen)
+	}
+}
+
+// calcPanes calculates the values of all panes for all items.
+func (o *OHLCChart) calcPanes(rects []rect) []ohlcPaneState {
+	ret := make([]ohlcPaneState, len(o.panes))
+
+	for i, pane := range o.panes {
+		scale := NewScale(o.factory, pane.scaleType)
+		scale.SetSize(rects[i].h)
+
+		values := make([][]DecimalValue, len(pane.overlays))
+
+		for j, overlay := range pane.overlays {
+			values[j] = o.calcOverlay(overlay)
+		}
+
+		ret[i] = ohlcPaneState{
+			pane:   pane,
+			rect:   rects[i],
+			scale:  scale,
+			values: values,
+		}
+	}
+
+	return ret
+}
+
+// selectedIndex returns the index of the item under the mouse pointer, the
+// item selected by the cursor, or the last visible item, in that order. It
+// returns -1 when there are no items.
+func (o *OHLCChart) selectedIndex(end int) int {
+	if o.hoverValid && o.hover >= 0 && o.hover < len(o.items) {
+		return o.hover
+	}
+
+	if o.cursorVisible && o.cursor >= 0 && o.cursor < len(o.items) {
+		return o.cursor
+	}
+
+	return end - 1
+}
+
+// drawCrosshair draws the crosshair lines crossing at x, y within r. The
+// lines are only drawn over empty cells so the data remains visible.
+func (o *OHLCChart) drawCrosshair(screen tcell.Screen, x, y int, r rect) {
+	style := o.crosshairStyle
+
+	for yy := r.y; yy < r.y+r.h; yy++ {
+		setContentIfEmpty(screen, x, yy, '│', style)
+	}
+
+	if y < r.y || y >= r.y+r.h {
+		return
+	}
+
+	for xx := r.x; xx < r.x+r.w; xx++ {
+		ch := '─'
+
+		if xx == x {
+			ch = '┼'
+		}
+
+		setContentIfEmpty(screen, xx, y, ch, style)
+	}
+}
+
+// drawGrid draws the horizontal gridlines at the ticks of axis within r.
+func (o *OHLCChart) drawGrid(screen tcell.Screen, r rect, axis *Axis) {
+	for _, tick := range axis.Ticks() {
+		yy := r.y + r.h - tick.Row - 1
+
+		for xx := r.x; xx < r.x+r.w; xx++ {
+			setContentIfEmpty(screen, xx, yy, '┈', o.gridStyle)
+		}
+	}
+}
+
+// ohlcView describes the items visible during the last Draw.
+type ohlcView struct {
+	// start is the index of the first visible item.
+	start int
+	// end is the index of the item after the last visible item.
+	end int
+	// x and w describe the columns taken by the items.
+	x, w int
+	// spacing is the number of columns taken by each item.
+	spacing int
+}
+
+// ohlcDrag contains the state of panning the chart with the mouse.
+type ohlcDrag struct {
+	active bool
+	// x is the column where the pointer was when the chart was last panned.
+	x int
 }
 
 type rect struct {
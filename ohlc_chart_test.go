@@ -2,11 +2,16 @@ package tplot_test
 
 import (
 	"fmt"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/gdamore/tcell/v2"
 	"github.com/jeremija/tplot"
+	"github.com/jeremija/tplot/indicators"
 	"github.com/jeremija/tplot/test"
+	"github.com/rivo/tview"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -44,36 +49,36 @@ func TestOHLCChart(t *testing.T) {
 	p.Draw(scr)
 
 	exp := `
-        ╷      30.00
-        │      28.91
-        │      27.83
-        │      26.74
-        │      25.65
-        │      24.57
-        │      23.48
-        │      22.39
-        │      21.30
-        │      20.22
-        │ ╷╻╷  19.13
-        │ │┃│  18.04
-        │ │┃│  16.96
-        │ │┃│  15.87
-        ╽─┼┃┼  15.00
-        ┃ │┃│  13.70
-        ┃ │┃│  12.61
-        ┃ │┃│  11.52
-        ┃ │┃│  10.43
-        ╿ │╹│   9.35
-        │ │ │   8.26
-        │ │ │   7.17
-        │ │ │   6.09
-        ╵ ╵ ╵   5.00
-            ▆1000.00
-            █ 840.00
-         ▆  █ 680.00
-         █  █ 520.00
-        ██  █ 360.00
-        ██ ▄█ 200.00`
+         ╷        30
+         │
+         │
+         │
+         │
+         │        25
+         │
+         │
+         │
+         │
+         │ ╷╻╷    20
+         │ │┃│
+         │ │┃│
+         │ │┃│
+         ╽─┼┃┼ 15.00
+         ┃ │┃│
+         ┃ │┃│
+         ┃ │┃│
+         ┃ │┃│
+         ╿ │╹│    10
+         │ │ │
+         │ │ │
+         │ │ │
+         ╵ ╵ ╵     5
+             █    1K
+          ▆  █
+         ▄█  █   500
+         ██ ▄█
+         █████     0
+`
 
 	fmt.Println("== expected ==")
 	fmt.Println(exp)
@@ -83,3 +88,512 @@ func TestOHLCChart(t *testing.T) {
 
 	assert.Equal(t, exp, "\n"+scr.Content())
 }
+
+func TestOHLCChart_stream(t *testing.T) {
+	var factory tplot.FloatFactory
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	item := func(h int, v int64) tplot.OHLC {
+		d := factory.NewFromInt64(v)
+
+		return tplot.OHLC{
+			Timestamp: start.Add(time.Duration(h) * time.Hour),
+			O:         d,
+			H:         d,
+			L:         d,
+			C:         d,
+			V:         d,
+		}
+	}
+
+	p := tplot.NewOHLCChart(factory)
+
+	p.UpdateLast(item(0, 1))
+	p.Append(item(1, 2))
+	p.Append(item(2, 3))
+	assert.Equal(t, 0, p.Offset())
+
+	p.SetOffset(1)
+	p.Append(item(3, 4))
+	assert.Equal(t, 2, p.Offset(), "offset should stay at the same items")
+
+	p.UpdateLast(item(3, 5))
+	assert.Equal(t, []tplot.OHLC{item(0, 1), item(1, 2), item(2, 3), item(3, 5)}, p.Items())
+	assert.Equal(t, 2, p.Offset())
+
+	p.SetOffset(0)
+	p.SetTimeframe(2 * time.Hour)
+	p.Append(item(4, 6))
+	p.UpdateLast(item(4, 7))
+	p.Append(item(5, 1))
+
+	p.SetMaxItems(3)
+	assert.Equal(t, []tplot.OHLC{item(3, 5), item(4, 7), item(5, 1)}, p.Items())
+
+	p.SetTimeframe(0)
+	p.SetTimeframe(2 * time.Hour)
+
+	p.SetCursor(1)
+	p.SetMaxItems(2)
+	assert.Equal(t, []tplot.OHLC{item(4, 7), item(5, 1)}, p.Items())
+	assert.Equal(t, 0, p.Cursor())
+
+	scr := test.NewScreen()
+	p.SetRect(0, 0, 20, 10)
+	p.Draw(scr)
+	assert.Equal(t, " O=7 H=7 L=1 C=1 V=8 TS=2020-01-01T04:00:00 ", p.GetTitle())
+
+	var wg sync.WaitGroup
+
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+
+		for i := 6; i < 1000; i++ {
+			p.Append(item(i, int64(i)))
+			p.UpdateLast(item(i, int64(i+1)))
+		}
+	}()
+
+	// Panes, overlays and settings can be changed at runtime too.
+	go func() {
+		defer wg.Done()
+
+		overlay := tplot.NewOverlay("high", &highIndicator{})
+		pane := tplot.NewOHLCPane(factory, "pane")
+
+		for i := 0; i < 1000; i++ {
+			p.AddOverlay(overlay)
+			p.AddPane(pane)
+			p.SetHLines([]tplot.HLine{{Value: factory.NewFromInt64(int64(i))}})
+			p.SetGridVisible(i%2 == 0)
+			p.ToggleOHLCScaleType()
+			p.AddSpacing(1)
+			p.SetSpacing(1)
+			p.RemovePane(pane)
+			p.RemoveOverlay(overlay)
+		}
+	}()
+
+	done := make(chan struct{})
+
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	for drawing := true; drawing; {
+		select {
+		case <-done:
+			drawing = false
+		default:
+			p.Draw(scr)
+		}
+	}
+
+	assert.Len(t, p.Items(), 2)
+}
+
+func TestOHLCChart_cursor(t *testing.T) {
+	var factory tplot.FloatFactory
+
+	p := tplot.NewOHLCChart(factory)
+	scr := test.NewScreen()
+
+	d := func(val int64) tplot.Decimal {
+		return factory.NewFromInt64(val)
+	}
+
+	ts := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	items := []tplot.OHLC{
+		{ts, d(10), d(14), d(8), d(12), d(100)},
+		{ts.Add(time.Hour), d(12), d(16), d(10), d(15), d(200)},
+		{ts.Add(2 * time.Hour), d(15), d(18), d(13), d(14), d(300)},
+		{ts.Add(3 * time.Hour), d(14), d(15), d(9), d(10), d(400)},
+	}
+
+	p.SetItems(items)
+	p.SetTimeAxisVisible(false)
+	p.SetRect(0, 0, 12, 12)
+	p.Draw(scr)
+
+	p.SetCursor(10)
+	assert.Equal(t, 3, p.Cursor(), "cursor stays within items")
+
+	p.MoveCursor(-5)
+	assert.Equal(t, 0, p.Cursor(), "cursor stays within items")
+
+	p.MoveCursor(1)
+	assert.Equal(t, 1, p.Cursor())
+
+	p.SetCursorVisible(true)
+	assert.Equal(t, 1, p.Cursor(), "visible cursor is not moved")
+
+	scr.Clear()
+	p.Draw(scr)
+
+	// The crosshair crosses at the close of the item, which is highlighted
+	// on the axis together with its volume.
+	exp := `
+   │╷
+   ││
+   ╷│
+───╽╽╷ 15.00
+  ╷┃╿╽
+  │┃╵┃
+  ╽╿ ┃
+  ┃│ ┃
+  ╿╵ ╿    10
+  ╵│ ╵
+   │▄█
+  ▄███   200`
+
+	fmt.Println("== expected ==")
+	fmt.Println(exp)
+	fmt.Println("==  actual  ==")
+	fmt.Println(scr.Content())
+	fmt.Println("==============")
+
+	assert.Equal(t, exp, "\n"+scr.Content())
+	assert.Equal(t, " O=12 H=16 L=10 C=15 V=200 TS=2020-01-01T01:00:00 ", p.GetTitle())
+
+	p.SetCursorVisible(false)
+
+	scr.Clear()
+	p.Draw(scr)
+
+	assert.NotContains(t, scr.Content(), "─")
+	assert.Equal(t, " O=14 H=15 L=9 C=10 V=400 TS=2020-01-01T03:00:00 ", p.GetTitle())
+}
+
+func TestOHLCChart_mouse(t *testing.T) {
+	var factory tplot.FloatFactory
+
+	p := tplot.NewOHLCChart(factory)
+	scr := test.NewScreen()
+
+	ts := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	items := make([]tplot.OHLC, 30)
+
+	for i := range items {
+		d := factory.NewFromInt64(int64(i))
+
+		items[i] = tplot.OHLC{ts.Add(time.Duration(i) * time.Hour), d, d, d, d, d}
+	}
+
+	p.SetItems(items)
+	p.SetTimeAxisVisible(false)
+	p.SetRect(0, 0, 20, 10)
+	p.Draw(scr)
+
+	handler := p.MouseHandler()
+	setFocus := func(tview.Primitive) {}
+
+	mouse := func(action tview.MouseAction, x int, mod tcell.ModMask) {
+		handler(action, tcell.NewEventMouse(x, 5, tcell.ButtonNone, mod), setFocus)
+		p.Draw(scr)
+	}
+
+	// selected returns the open price of the item in the title, which is
+	// the same as its index.
+	selected := func() string {
+		return p.GetTitle()[len(" O="):strings.Index(p.GetTitle(), " H=")]
+	}
+
+	key := func(key tcell.Key) {
+		p.InputHandler()(tcell.NewEventKey(key, 0, tcell.ModNone), setFocus)
+		p.Draw(scr)
+	}
+
+	assert.Equal(t, "29", selected(), "last item")
+
+	mouse(tview.MouseMove, 5, 0)
+	assert.Equal(t, "21", selected())
+
+	key(tcell.KeyLeft)
+	assert.Equal(t, 1, p.Offset())
+	assert.Equal(t, "28", selected(), "keys clear the hover")
+
+	mouse(tview.MouseMove, 5, 0)
+	assert.Equal(t, "20", selected())
+
+	// Drag to the right by three items.
+	mouse(tview.MouseLeftDown, 5, 0)
+	mouse(tview.MouseMove, 7, 0)
+	mouse(tview.MouseMove, 8, 0)
+	mouse(tview.MouseLeftUp, 8, 0)
+	assert.Equal(t, 4, p.Offset())
+	assert.Equal(t, "25", selected(), "panning clears the hover")
+
+	mouse(tview.MouseMove, 5, 0)
+	assert.Equal(t, "17", selected())
+
+	// Zoom in around the hovered item.
+	mouse(tview.MouseScrollUp, 5, tcell.ModCtrl)
+	assert.Equal(t, 2, p.Spacing())
+	assert.Equal(t, "21", selected(), "zooming clears the hover")
+
+	mouse(tview.MouseMove, 5, 0)
+	assert.Equal(t, "17", selected(), "item stays under the pointer")
+
+	// Scroll without Ctrl.
+	mouse(tview.MouseScrollDown, 5, 0)
+	assert.Equal(t, 0, p.Offset())
+	assert.Equal(t, "29", selected())
+
+	mouse(tview.MouseMove, 5, 0)
+	assert.Equal(t, "25", selected())
+
+	mouse(tview.MouseLeftClick, 5, 0)
+	assert.True(t, p.CursorVisible())
+	assert.Equal(t, 25, p.Cursor())
+
+	// The cursor replaces the hover when it is moved.
+	key(tcell.KeyLeft)
+	assert.Equal(t, "24", selected())
+}
+
+// highIndicator returns the high prices of all but the first skip items,
+// and counts how many times it was called.
+type highIndicator struct {
+	skip  int
+	calls int
+}
+
+func (c *highIndicator) Calculate(items []tplot.OHLC) []tplot.DecimalValue {
+	c.calls++
+
+	var ret []tplot.DecimalValue
+
+	for i := c.skip; i < len(items); i++ {
+		ret = append(ret, tplot.DecimalValue{Decimal: items[i].H, Valid: true})
+	}
+
+	return ret
+}
+
+func TestOHLCChart_overlay(t *testing.T) {
+	var factory tplot.FloatFactory
+
+	p := tplot.NewOHLCChart(factory)
+	scr := test.NewScreen()
+
+	ts := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	item := func(i int, c int64) tplot.OHLC {
+		d := factory.NewFromInt64(c)
+		h := factory.NewFromInt64(c + 4)
+
+		return tplot.OHLC{ts.Add(time.Duration(i) * time.Hour), d, h, d, d, d}
+	}
+
+	p.SetItems([]tplot.OHLC{item(0, 1), item(1, 2), item(2, 4), item(3, 3)})
+
+	// The indicator returns fewer values than items.
+	indicator := &highIndicator{skip: 2}
+	p.AddOverlay(tplot.NewOverlay("high", indicator))
+
+	p.SetTimeAxisVisible(false)
+	p.SetVolumeHeight(0)
+	p.SetRect(0, 0, 10, 8)
+	p.Draw(scr)
+
+	// The line starts at the first item with a value.
+	exp := `
+   ─╮    8
+   │╰
+  ╷││    6
+ ╷│││
+ ││┴│
+ ││ ┴ 3.00
+ │┴
+ ┴`
+
+	fmt.Println("== expected ==")
+	fmt.Println(exp)
+	fmt.Println("==  actual  ==")
+	fmt.Println(scr.Content())
+	fmt.Println("==============")
+
+	assert.Equal(t, exp, "\n"+scr.Content())
+
+	p.Draw(scr)
+	assert.Equal(t, 1, indicator.calls, "values are cached")
+
+	p.Append(item(4, 1))
+	p.Draw(scr)
+	assert.Equal(t, 2, indicator.calls, "values are calculated for new items")
+}
+
+func TestOHLCChart_pane(t *testing.T) {
+	var factory tplot.FloatFactory
+
+	p := tplot.NewOHLCChart(factory)
+	scr := test.NewScreen()
+
+	ts := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	closes := []int64{10, 11, 12, 11, 13, 14, 13, 15}
+	items := make([]tplot.OHLC, len(closes))
+
+	for i, c := range closes {
+		d := factory.NewFromInt64(c)
+
+		items[i] = tplot.OHLC{ts.Add(time.Duration(i) * time.Hour), d, d, d, d, d}
+	}
+
+	p.SetItems(items)
+
+	pane := tplot.NewOHLCPane(factory, "RSI")
+	pane.SetHeight(0.5)
+	pane.AddOverlay(tplot.NewOverlay("RSI(2)", indicators.NewRSI(factory, 2)))
+
+	p.AddPane(pane)
+	p.SetTimeAxisVisible(false)
+	p.SetVolumeHeight(0)
+	p.SetRect(0, 0, 14, 8)
+	p.Draw(scr)
+
+	// The pane takes half of the height, and the RSI line starts at the
+	// third item, aligned with its candle.
+	exp := `
+      ─  15.00
+    ─
+   ─ ─
+───
+ ─╮        100
+  │ ╭╮
+  │╭╯│╭  82.00
+  ╰╯ ╰╯`
+
+	fmt.Println("== expected ==")
+	fmt.Println(exp)
+	fmt.Println("==  actual  ==")
+	fmt.Println(scr.Content())
+	fmt.Println("==============")
+
+	assert.Equal(t, exp, "\n"+scr.Content())
+	assert.Equal(t, []*tplot.OHLCPane{pane}, p.Panes())
+
+	p.RemovePane(pane)
+	assert.Empty(t, p.Panes())
+
+	scr.Clear()
+	p.Draw(scr)
+
+	exp = `
+       ─ 15.00
+
+     ─      14
+    ─ ─
+
+  ─         12
+ ─ ─
+─           10`
+
+	assert.Equal(t, exp, "\n"+scr.Content())
+}
+
+func TestOHLCChart_paneConcurrency(t *testing.T) {
+	var factory tplot.FloatFactory
+
+	p := tplot.NewOHLCChart(factory)
+	d := factory.NewFromInt64(1)
+	p.SetItems([]tplot.OHLC{{time.Now(), d, d, d, d, d}})
+	p.SetRect(0, 0, 20, 10)
+
+	pane := tplot.NewOHLCPane(factory, "pane")
+	p.AddPane(pane)
+
+	done := make(chan struct{})
+
+	// The panes can be changed while the chart is being drawn.
+	go func() {
+		defer close(done)
+
+		overlay := tplot.NewOverlay("high", &highIndicator{})
+
+		for i := 0; i < 1000; i++ {
+			pane.AddOverlay(overlay)
+			pane.SetHeight(float64(i%5) / 10)
+			pane.SetScaleType(tplot.ScaleType(i % 2))
+			pane.SetHighlightOverlay(overlay)
+			pane.RemoveOverlay(overlay)
+		}
+	}()
+
+	scr := test.NewScreen()
+
+	for drawing := true; drawing; {
+		select {
+		case <-done:
+			drawing = false
+		default:
+			p.Draw(scr)
+		}
+	}
+
+	assert.Empty(t, pane.Overlays())
+}
+
+func TestOHLCChart_paneHighlight(t *testing.T) {
+	var factory tplot.FloatFactory
+
+	p := tplot.NewOHLCChart(factory)
+	scr := test.NewScreen()
+
+	ts := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	closes := []int64{10, 11, 12, 11, 13, 14, 13, 15}
+	items := make([]tplot.OHLC, len(closes))
+
+	for i, c := range closes {
+		d := factory.NewFromInt64(c)
+
+		items[i] = tplot.OHLC{ts.Add(time.Duration(i) * time.Hour), d, d, d, d, d}
+	}
+
+	p.SetItems(items)
+	p.SetTimeAxisVisible(false)
+	p.SetRect(0, 0, 20, 12)
+
+	high := &highIndicator{}
+
+	histogram := tplot.NewOverlay("high", high)
+	histogram.SetType(tplot.OverlayHistogram)
+
+	line := tplot.NewOverlay("RSI(2)", indicators.NewRSI(factory, 2))
+
+	pane := tplot.NewOHLCPane(factory, "pane")
+	pane.AddOverlay(histogram)
+	pane.AddOverlay(line)
+	p.AddPane(pane)
+
+	rsi := indicators.NewRSI(factory, 2).Calculate(items)
+
+	p.Draw(scr)
+	assert.Equal(t, rsi[len(rsi)-1], pane.Axis().Highlight(), "the line is highlighted by default")
+	assert.Equal(t, 1, high.calls)
+
+	pane.SetHighlightOverlay(histogram)
+	assert.Equal(t, histogram, pane.HighlightOverlay())
+
+	p.Draw(scr)
+	assert.Equal(t, tplot.DecimalValue{Decimal: tplot.Float(15), Valid: true}, pane.Axis().Highlight())
+	assert.Equal(t, 1, high.calls, "the values are cached")
+
+	// The values of the removed overlays are calculated again.
+	p.RemovePane(pane)
+	p.AddPane(pane)
+	p.Draw(scr)
+	assert.Equal(t, 2, high.calls)
+
+	pane.RemoveOverlay(histogram)
+	pane.AddOverlay(histogram)
+	p.Draw(scr)
+	assert.Equal(t, 3, high.calls)
+}
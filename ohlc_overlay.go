@@ -0,0 +1,137 @@
+package tplot
+
+import "github.com/gdamore/tcell/v2"
+
+// OverlayType describes how the Overlay values are drawn.
+type OverlayType int
+
+const (
+	// OverlayLine draws the values as a line.
+	OverlayLine OverlayType = iota
+	// OverlayHistogram draws the values as bars growing from zero, for
+	// example the MACD histogram.
+	OverlayHistogram
+)
+
+// Overlay draws the values of an Indicator over the OHLC candles, sharing
+// their scale, or in an OHLCPane.
+type Overlay struct {
+	name        string
+	indicator   Indicator
+	style       tcell.Style
+	runes       []rune
+	overlayType OverlayType
+}
+
+// NewOverlay creates a new instance of Overlay.
+func NewOverlay(name string, indicator Indicator) *Overlay {
+	return &Overlay{
+		name:      name,
+		indicator: indicator,
+		style:     tcell.StyleDefault,
+		runes:     DefaultLinesRunes,
+	}
+}
+
+// Name returns the overlay name.
+func (o *Overlay) Name() string {
+	return o.name
+}
+
+// Indicator returns the indicator used to calculate the values.
+func (o *Overlay) Indicator() Indicator {
+	return o.indicator
+}
+
+// SetStyle sets the line style.
+func (o *Overlay) SetStyle(style tcell.Style) {
+	o.style = style
+}
+
+// Style returns the line style.
+func (o *Overlay) Style() tcell.Style {
+	return o.style
+}
+
+// SetType sets how the values are drawn. OverlayLine is the default.
+func (o *Overlay) SetType(overlayType OverlayType) {
+	o.overlayType = overlayType
+}
+
+// Type returns how the values are drawn.
+func (o *Overlay) Type() OverlayType {
+	return o.overlayType
+}
+
+// SetRunes sets the runes used to draw the line. See DefaultLinesRunes.
+func (o *Overlay) SetRunes(runes []rune) {
+	o.runes = runes
+}
+
+// Runes returns the runes used to draw the line.
+func (o *Overlay) Runes() []rune {
+	return o.runes
+}
+
+// draw draws values aligned to the right edge of r.
+func (o *Overlay) draw(
+	screen tcell.Screen,
+	factory DecimalFactory,
+	r rect,
+	scale Scale,
+	spacing int,
+	values []DecimalValue,
+) {
+	if o.overlayType == OverlayHistogram {
+		drawHistogram(screen, r, scale, spacing, values, factory.Zero(), o.style)
+		return
+	}
+
+	drawLine(screen, r, scale, spacing, values, o.runes, o.style)
+}
+
+// drawHistogram draws values aligned to the right edge of r as bars growing
+// up or down from zero. The scale must already have the size set to the
+// height of r.
+func drawHistogram(
+	screen tcell.Screen,
+	r rect,
+	scale Scale,
+	spacing int,
+	values []DecimalValue,
+	zero Decimal,
+	style tcell.Style,
+) {
+	clamp := func(v int) int {
+		if v < 0 {
+			return 0
+		}
+
+		if v >= r.h {
+			return r.h - 1
+		}
+
+		return v
+	}
+
+	base := clamp(scale.Value(zero))
+
+	for i, value := range values {
+		if !value.Valid || value.Decimal.IsZero() {
+			continue
+		}
+
+		v := clamp(scale.Value(value.Decimal))
+		xx := r.x + i*spacing + (r.w - len(values)*spacing)
+
+		from, to := base, v
+		if from > to {
+			from, to = to, from
+		}
+
+		for j := from; j <= to; j++ {
+			yy := r.y + r.h - j - 1
+			screen.SetContent(xx, yy, '█', nil, style)
+		}
+	}
+}
@@ -0,0 +1,188 @@
+package tplot
+
+// OHLCPane is a pane drawn below the OHLC candles with its own Axis and
+// Scale. It is used for indicators that cannot share the price scale, for
+// example oscillators. The items in the pane are aligned with the candles.
+//
+// The methods of OHLCPane are goroutine-safe once the pane is added to an
+// OHLCChart, like the methods of the chart.
+type OHLCPane struct {
+	// chart is the chart the pane was added to, whose mutex guards the state
+	// of the pane. It is nil before the pane is added.
+	chart *OHLCChart
+
+	name      string
+	axis      *Axis
+	scaleType ScaleType
+	overlays  []*Overlay
+	highlight *Overlay
+
+	// heightFraction is a number between 0 and 1 that determines how much of
+	// the layout should be taken by the pane.
+	heightFraction float64
+}
+
+// NewOHLCPane creates a new instance of OHLCPane.
+func NewOHLCPane(factory DecimalFactory, name string) *OHLCPane {
+	return &OHLCPane{
+		name:           name,
+		axis:           NewAxis(factory),
+		heightFraction: 0.2,
+	}
+}
+
+// lock locks the mutex of the chart the pane was added to, and returns the
+// function that unlocks it.
+func (p *OHLCPane) lock() (unlock func()) {
+	if p.chart == nil {
+		return func() {}
+	}
+
+	p.chart.mu.Lock()
+
+	return p.chart.mu.Unlock
+}
+
+// Name returns the pane name.
+func (p *OHLCPane) Name() string {
+	return p.name
+}
+
+// SetHeight sets the fraction of the chart height taken by the pane.
+func (p *OHLCPane) SetHeight(fraction float64) {
+	defer p.lock()()
+
+	if fraction < 0 {
+		fraction = 0
+	}
+
+	if fraction > 1 {
+		fraction = 1
+	}
+
+	p.heightFraction = fraction
+}
+
+// Height returns the fraction of the chart height taken by the pane.
+func (p *OHLCPane) Height() float64 {
+	defer p.lock()()
+
+	return p.heightFraction
+}
+
+// Axis returns the pane axis.
+func (p *OHLCPane) Axis() *Axis {
+	return p.axis
+}
+
+// SetScaleType sets the type of the scale used in the pane.
+func (p *OHLCPane) SetScaleType(scaleType ScaleType) {
+	defer p.lock()()
+
+	p.scaleType = scaleType
+}
+
+// ScaleType returns the type of the scale used in the pane.
+func (p *OHLCPane) ScaleType() ScaleType {
+	defer p.lock()()
+
+	return p.scaleType
+}
+
+// AddOverlay adds an overlay drawn in the pane.
+func (p *OHLCPane) AddOverlay(overlay *Overlay) {
+	defer p.lock()()
+
+	p.overlays = append(p.overlays, overlay)
+}
+
+// RemoveOverlay removes a previously added overlay.
+func (p *OHLCPane) RemoveOverlay(overlay *Overlay) {
+	defer p.lock()()
+
+	for i, ov := range p.overlays {
+		if ov == overlay {
+			p.overlays = append(p.overlays[:i:i], p.overlays[i+1:]...)
+
+			if p.chart != nil {
+				delete(p.chart.overlayValues, overlay)
+			}
+
+			return
+		}
+	}
+}
+
+// Overlays returns the overlays drawn in the pane.
+func (p *OHLCPane) Overlays() []*Overlay {
+	defer p.lock()()
+
+	return p.overlays
+}
+
+// SetHighlightOverlay sets the overlay whose value of the selected item is
+// highlighted on the axis. When overlay is nil or not in the pane, the first
+// overlay that is not a histogram is highlighted, e.g. the MACD line instead
+// of the MACD histogram.
+func (p *OHLCPane) SetHighlightOverlay(overlay *Overlay) {
+	defer p.lock()()
+
+	p.highlight = overlay
+}
+
+// HighlightOverlay returns the overlay set by SetHighlightOverlay. May be nil.
+func (p *OHLCPane) HighlightOverlay() *Overlay {
+	defer p.lock()()
+
+	return p.highlight
+}
+
+// highlightIndex returns the index of the overlay highlighted on the axis, or
+// -1 when there are no overlays.
+func (p *OHLCPane) highlightIndex() int {
+	for i, overlay := range p.overlays {
+		if overlay == p.highlight {
+			return i
+		}
+	}
+
+	for i, overlay := range p.overlays {
+		if overlay.overlayType != OverlayHistogram {
+			return i
+		}
+	}
+
+	if len(p.overlays) > 0 {
+		return 0
+	}
+
+	return -1
+}
+
+// ohlcPaneState contains the values calculated for drawing an OHLCPane.
+type ohlcPaneState struct {
+	pane   *OHLCPane
+	rect   rect
+	scale  Scale
+	values [][]DecimalValue
+}
+
+// calcRange returns the range of values for n items starting at start.
+// Zero is always included when there is a histogram.
+func (p ohlcPaneState) calcRange(factory DecimalFactory, start int, n int) Range {
+	rng := NewRange(factory)
+
+	for i, values := range p.values {
+		if p.pane.overlays[i].overlayType == OverlayHistogram {
+			rng = rng.Feed(factory.Zero())
+		}
+
+		for _, value := range values[start : start+n] {
+			if value.Valid {
+				rng = rng.Feed(value.Decimal)
+			}
+		}
+	}
+
+	return rng
+}
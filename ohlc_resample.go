@@ -0,0 +1,123 @@
+package tplot
+
+import (
+	"sort"
+	"time"
+)
+
+// ResampleOHLC aggregates items into coarser buckets of duration d. Each
+// bucket has the open of the first item, the maximum high, the minimum low,
+// the close of the last item and the sum of volumes. Buckets are aligned in
+// the location of the timestamps, see truncateTime. The items must be sorted
+// by timestamp. When d is not positive, items are returned as is.
+func ResampleOHLC(items []OHLC, d time.Duration) []OHLC {
+	if d <= 0 {
+		return items
+	}
+
+	var ret []OHLC
+
+	for _, item := range items {
+		ret = resampleAppend(ret, item, d)
+	}
+
+	return ret
+}
+
+// resampleAppend adds item to the last bucket of resampled when it belongs
+// to it, or appends a new bucket otherwise.
+func resampleAppend(resampled []OHLC, item OHLC, d time.Duration) []OHLC {
+	ts := truncateTime(item.Timestamp, d)
+
+	if l := len(resampled); l > 0 && resampled[l-1].Timestamp.Equal(ts) {
+		resampled[l-1] = mergeOHLC(resampled[l-1], item)
+
+		return resampled
+	}
+
+	item.Timestamp = ts
+
+	return append(resampled, item)
+}
+
+// calendarDay is the duration of a day without daylight saving changes.
+const calendarDay = 24 * time.Hour
+
+// mondayDays is the number of days from the Unix epoch to the first Monday.
+const mondayDays = 4
+
+// truncateTime returns the start of the bucket of duration d containing ts,
+// in the location of ts. Buckets of whole days start at midnight, and
+// buckets of whole weeks start on Monday. Buckets of a part of a day that
+// divides the day are aligned to midnight. Other buckets are aligned as by
+// time.Time.Truncate.
+func truncateTime(ts time.Time, d time.Duration) time.Time {
+	year, month, dd := ts.Date()
+	loc := ts.Location()
+
+	switch {
+	case d%calendarDay == 0:
+		// The number of the calendar day, which does not depend on the
+		// location.
+		days := time.Date(year, month, dd, 0, 0, 0, 0, time.UTC).Unix() / int64(calendarDay/time.Second)
+		n := int64(d / calendarDay)
+
+		days -= mod(days-mondayDays, n)
+
+		return time.Date(1970, time.January, 1+int(days), 0, 0, 0, 0, loc)
+	case calendarDay%d == 0:
+		midnight := time.Date(year, month, dd, 0, 0, 0, 0, loc)
+
+		return midnight.Add(ts.Sub(midnight).Truncate(d))
+	default:
+		return ts.Truncate(d)
+	}
+}
+
+// mod returns the non-negative remainder of a divided by b.
+func mod(a, b int64) int64 {
+	m := a % b
+	if m < 0 {
+		m += b
+	}
+
+	return m
+}
+
+// mergeOHLC merges item into the bucket that started before it.
+func mergeOHLC(bucket OHLC, item OHLC) OHLC {
+	if item.H.GreaterThan(bucket.H) {
+		bucket.H = item.H
+	}
+
+	if item.L.LessThan(bucket.L) {
+		bucket.L = item.L
+	}
+
+	bucket.C = item.C
+	bucket.V = bucket.V.Add(item.V)
+
+	return bucket
+}
+
+// searchOHLC returns the index of the last item with timestamp not after ts,
+// or 0 when there is no such item. The items must be sorted by timestamp.
+func searchOHLC(items []OHLC, ts time.Time) int {
+	i := sort.Search(len(items), func(i int) bool {
+		return items[i].Timestamp.After(ts)
+	})
+
+	if i > 0 {
+		i--
+	}
+
+	return i
+}
+
+// searchOHLCFrom returns the index of the first item with timestamp not
+// before ts, or len(items) when there is no such item.
+func searchOHLCFrom(items []OHLC, ts time.Time) int {
+	return sort.Search(len(items), func(i int) bool {
+		return !items[i].Timestamp.Before(ts)
+	})
+}
@@ -0,0 +1,137 @@
+package tplot_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jeremija/tplot"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResampleOHLC(t *testing.T) {
+	var factory tplot.FloatFactory
+
+	d := func(val int64) tplot.Decimal {
+		return factory.NewFromInt64(val)
+	}
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	hour := func(h int) time.Time {
+		return start.Add(time.Duration(h) * time.Hour)
+	}
+
+	items := []tplot.OHLC{
+		{hour(0), d(10), d(30), d(5), d(15), d(500)},
+		{hour(1), d(15), d(16), d(14), d(15), d(750)},
+		{hour(2), d(15), d(20), d(3), d(18), d(200)},
+		{hour(3), d(18), d(20), d(10), d(10), d(300)},
+		{hour(5), d(12), d(40), d(11), d(20), d(100)},
+	}
+
+	assert.Equal(t, items, tplot.ResampleOHLC(items, 0))
+
+	assert.Equal(t, []tplot.OHLC{
+		{hour(0), d(10), d(30), d(5), d(15), d(1250)},
+		{hour(2), d(15), d(20), d(3), d(10), d(500)},
+		{hour(4), d(12), d(40), d(11), d(20), d(100)},
+	}, tplot.ResampleOHLC(items, 2*time.Hour))
+
+	assert.Equal(t, []tplot.OHLC{
+		{hour(0), d(10), d(40), d(3), d(20), d(1850)},
+	}, tplot.ResampleOHLC(items, 24*time.Hour))
+
+	assert.Nil(t, tplot.ResampleOHLC(nil, time.Hour))
+}
+
+func TestResampleOHLC_location(t *testing.T) {
+	var factory tplot.FloatFactory
+
+	d := func(val int64) tplot.Decimal {
+		return factory.NewFromInt64(val)
+	}
+
+	// Midnight in UTC+3 is at 21:00 in UTC.
+	loc := time.FixedZone("UTC+3", 3*60*60)
+
+	items := []tplot.OHLC{
+		{time.Date(2020, 1, 1, 1, 0, 0, 0, loc), d(1), d(1), d(1), d(1), d(1)},
+		{time.Date(2020, 1, 1, 23, 0, 0, 0, loc), d(2), d(2), d(2), d(2), d(1)},
+		{time.Date(2020, 1, 2, 2, 0, 0, 0, loc), d(3), d(3), d(3), d(3), d(1)},
+		// Sunday and Monday.
+		{time.Date(2020, 1, 5, 2, 0, 0, 0, loc), d(4), d(4), d(4), d(4), d(1)},
+		{time.Date(2020, 1, 6, 2, 0, 0, 0, loc), d(5), d(5), d(5), d(5), d(1)},
+	}
+
+	assert.Equal(t, []tplot.OHLC{
+		{time.Date(2020, 1, 1, 0, 0, 0, 0, loc), d(1), d(2), d(1), d(2), d(2)},
+		{time.Date(2020, 1, 2, 0, 0, 0, 0, loc), d(3), d(3), d(3), d(3), d(1)},
+		{time.Date(2020, 1, 5, 0, 0, 0, 0, loc), d(4), d(4), d(4), d(4), d(1)},
+		{time.Date(2020, 1, 6, 0, 0, 0, 0, loc), d(5), d(5), d(5), d(5), d(1)},
+	}, tplot.ResampleOHLC(items, 24*time.Hour))
+
+	// Weeks start on Monday, 2019-12-30 and 2020-01-06.
+	assert.Equal(t, []tplot.OHLC{
+		{time.Date(2019, 12, 30, 0, 0, 0, 0, loc), d(1), d(4), d(1), d(4), d(4)},
+		{time.Date(2020, 1, 6, 0, 0, 0, 0, loc), d(5), d(5), d(5), d(5), d(1)},
+	}, tplot.ResampleOHLC(items, 7*24*time.Hour))
+
+	// Hours are aligned to the local midnight in a zone with a half-hour
+	// offset.
+	loc = time.FixedZone("UTC+5:30", 5*60*60+30*60)
+
+	items = []tplot.OHLC{
+		{time.Date(2020, 1, 1, 1, 10, 0, 0, loc), d(1), d(1), d(1), d(1), d(1)},
+		{time.Date(2020, 1, 1, 1, 50, 0, 0, loc), d(2), d(2), d(2), d(2), d(1)},
+	}
+
+	assert.Equal(t, []tplot.OHLC{
+		{time.Date(2020, 1, 1, 1, 0, 0, 0, loc), d(1), d(2), d(1), d(2), d(2)},
+	}, tplot.ResampleOHLC(items, time.Hour))
+}
+
+func TestOHLCChart_timeframe(t *testing.T) {
+	var factory tplot.FloatFactory
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	items := make([]tplot.OHLC, 48)
+
+	for i := range items {
+		v := factory.NewFromInt64(int64(i + 1))
+
+		items[i] = tplot.OHLC{
+			Timestamp: start.Add(time.Duration(i) * time.Hour),
+			O:         v,
+			H:         v,
+			L:         v,
+			C:         v,
+			V:         v,
+		}
+	}
+
+	p := tplot.NewOHLCChart(factory)
+	p.SetItems(items)
+	p.SetTimeframes([]time.Duration{0, 6 * time.Hour, 24 * time.Hour})
+
+	// The right edge is at hour 35.
+	p.SetOffset(12)
+
+	p.NextTimeframe()
+	assert.Equal(t, 6*time.Hour, p.Timeframe())
+	assert.Equal(t, items, p.Items())
+	// The bucket containing hour 35 starts at hour 30, index 5 of 8.
+	assert.Equal(t, 2, p.Offset())
+
+	p.NextTimeframe()
+	assert.Equal(t, 24*time.Hour, p.Timeframe())
+	assert.Equal(t, 0, p.Offset())
+
+	p.NextTimeframe()
+	assert.Equal(t, time.Duration(0), p.Timeframe())
+	// The right edge is at the last hour of the second day.
+	assert.Equal(t, 0, p.Offset())
+
+	p.PrevTimeframe()
+	assert.Equal(t, 24*time.Hour, p.Timeframe())
+}
@@ -5,4 +5,22 @@ type Position int
 const (
 	Right Position = iota
 	Left
+	// Top places a horizontal axis above the content.
+	Top
+	// Bottom places a horizontal axis below the content.
+	Bottom
+	// TopLeft places a legend over the top left corner of the content.
+	TopLeft
+	// TopRight places a legend over the top right corner of the content.
+	TopRight
+	// BottomLeft places a legend over the bottom left corner of the content.
+	BottomLeft
+	// BottomRight places a legend over the bottom right corner of the
+	// content.
+	BottomRight
 )
+
+// isCorner returns true for the positions over the corners of the content.
+func (p Position) isCorner() bool {
+	return p == TopLeft || p == TopRight || p == BottomLeft || p == BottomRight
+}
@@ -14,3 +14,12 @@ type Primitive interface {
 	// Scale returns the current scale used by the Primitive.
 	Scale() Scale
 }
+
+// PlotArea is an optional interface of a Primitive that does not draw the
+// data over its whole rect, for example because it draws labels next to the
+// data. AxisBox uses it to align the axis with the data.
+type PlotArea interface {
+	// PlotRect returns the rect where the data was drawn during the last
+	// Draw.
+	PlotRect() (x, y, width, height int)
+}
@@ -0,0 +1,77 @@
+package tplot
+
+// RingBuffer is a DataSource with a fixed capacity. When the buffer is full,
+// pushing a value overwrites the oldest one, so the last values of a
+// continuously growing metric can be kept at constant memory. RingBuffer is
+// not safe for concurrent use.
+type RingBuffer struct {
+	values []Decimal
+	start  int
+	len    int
+}
+
+var _ DataSource = &RingBuffer{}
+
+// NewRingBuffer creates a new RingBuffer that holds up to capacity values.
+func NewRingBuffer(capacity int) *RingBuffer {
+	if capacity < 0 {
+		capacity = 0
+	}
+
+	return &RingBuffer{
+		values: make([]Decimal, capacity),
+	}
+}
+
+// Push adds value after the last value, overwriting the oldest value when the
+// buffer is full.
+func (r *RingBuffer) Push(value Decimal) {
+	c := len(r.values)
+	if c == 0 {
+		return
+	}
+
+	if r.len < c {
+		r.values[(r.start+r.len)%c] = value
+		r.len++
+
+		return
+	}
+
+	r.values[r.start] = value
+	r.start = (r.start + 1) % c
+}
+
+// Len implements DataSource.
+func (r *RingBuffer) Len() int {
+	return r.len
+}
+
+// Cap returns the maximum number of values.
+func (r *RingBuffer) Cap() int {
+	return len(r.values)
+}
+
+// At implements DataSource. Index 0 is the oldest value. It panics when i
+// is not less than Len.
+func (r *RingBuffer) At(i int) Decimal {
+	checkIndex(i, r.len)
+
+	return r.values[(r.start+i)%len(r.values)]
+}
+
+// Window returns up to n values starting at start without copying them. The
+// window refers to the buffer, so it should not be used after Push.
+func (r *RingBuffer) Window(start, n int) DataSource {
+	return window(r, start, n)
+}
+
+// Clear removes all values.
+func (r *RingBuffer) Clear() {
+	for i := range r.values {
+		r.values[i] = nil
+	}
+
+	r.start = 0
+	r.len = 0
+}
@@ -0,0 +1,76 @@
+package tplot_test
+
+import (
+	"testing"
+
+	"github.com/jeremija/tplot"
+	"github.com/jeremija/tplot/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRingBuffer(t *testing.T) {
+	r := tplot.NewRingBuffer(3)
+
+	values := func(src tplot.DataSource) []tplot.Decimal {
+		ret := []tplot.Decimal{}
+
+		for i := 0; i < src.Len(); i++ {
+			ret = append(ret, src.At(i))
+		}
+
+		return ret
+	}
+
+	assert.Equal(t, 0, r.Len())
+	assert.Equal(t, 3, r.Cap())
+
+	r.Push(tplot.Float(1))
+	r.Push(tplot.Float(2))
+	assert.Equal(t, []tplot.Decimal{tplot.Float(1), tplot.Float(2)}, values(r))
+
+	r.Push(tplot.Float(3))
+	r.Push(tplot.Float(4))
+	r.Push(tplot.Float(5))
+	assert.Equal(t, 3, r.Len())
+	assert.Equal(t, []tplot.Decimal{tplot.Float(3), tplot.Float(4), tplot.Float(5)}, values(r))
+
+	assert.Equal(t, []tplot.Decimal{tplot.Float(4), tplot.Float(5)}, values(r.Window(1, 5)))
+	assert.Equal(t, []tplot.Decimal{tplot.Float(3)}, values(r.Window(0, 1)))
+	assert.Equal(t, []tplot.Decimal{}, values(r.Window(3, 1)))
+
+	assert.PanicsWithValue(t, "index out of range [3] with length 3", func() { r.At(3) })
+	assert.PanicsWithValue(t, "index out of range [-1] with length 3", func() { r.At(-1) })
+	assert.Panics(t, func() { r.Window(1, 5).At(2) })
+
+	r.Clear()
+	assert.Equal(t, 0, r.Len())
+	assert.Panics(t, func() { r.At(0) })
+}
+
+func TestTicks_ringBuffer(t *testing.T) {
+	var factory tplot.FloatFactory
+
+	r := tplot.NewRingBuffer(4)
+
+	for i := 0; i < 10; i++ {
+		r.Push(tplot.Float(i % 5))
+	}
+
+	p := tplot.NewTicks(factory)
+	p.SetRect(0, 0, 3, 5)
+	p.SetRunes([]rune{'-'})
+	p.SetSource(r)
+
+	scr := test.NewScreen()
+	p.Draw(scr)
+
+	exp := `
+  -
+
+ -
+
+-`
+
+	assert.Equal(t, exp, "\n"+scr.Content())
+	assert.Equal(t, []tplot.Decimal{tplot.Float(7 % 5), tplot.Float(8 % 5), tplot.Float(9 % 5)}, p.DataSlice())
+}
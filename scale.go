@@ -20,3 +20,30 @@ type Scale interface {
 	// scale.
 	Reverse(int) Decimal
 }
+
+// ScaleTicker is an optional interface of a Scale that chooses the values
+// labeled on an Axis.
+type ScaleTicker interface {
+	// Ticks returns the tick values within the range, from the lowest, with
+	// at least minGap places on the scale between two ticks.
+	Ticks(minGap int) []Decimal
+}
+
+// ScaleType describes the type of a Scale.
+type ScaleType int
+
+const (
+	// ScaleTypeLinear represents ScaleLinear.
+	ScaleTypeLinear ScaleType = iota
+	// ScaleTypeLog represents ScaleLog.
+	ScaleTypeLog
+)
+
+// NewScale creates a new Scale of scaleType.
+func NewScale(factory DecimalFactory, scaleType ScaleType) Scale {
+	if scaleType == ScaleTypeLog {
+		return NewScaleLog(factory)
+	}
+
+	return NewScaleLinear(factory)
+}
@@ -12,7 +12,10 @@ type ScaleLinear struct {
 	size int
 }
 
-var _ Scale = &ScaleLinear{}
+var (
+	_ Scale       = &ScaleLinear{}
+	_ ScaleTicker = &ScaleLinear{}
+)
 
 // NewLinear constructs a new linear scale.
 func NewScaleLinear(factory DecimalFactory) *ScaleLinear {
@@ -47,9 +50,16 @@ func (a *ScaleLinear) SetSize(size int) {
 }
 
 func (a *ScaleLinear) Reverse(i int) Decimal {
+	s := a.size - 1
+	if s <= 0 {
+		return a.rng.Min
+	}
+
 	val := a.factory.NewFromInt64(int64(i))
 
-	return a.rng.Min.Add(val.Mul(a.step()))
+	// Multiplying before dividing keeps the precision of fixed-point
+	// decimals.
+	return a.rng.Min.Add(a.rng.Max.Sub(a.rng.Min).Mul(val).Div(a.factory.NewFromInt64(int64(s))))
 }
 
 func (a *ScaleLinear) NumDecimals() int {
@@ -79,21 +89,23 @@ func (a *ScaleLinear) step() Decimal {
 	return step
 }
 
-func (a *ScaleLinear) scale() Decimal {
+// Value returns a scaled value from decimal.
+func (a *ScaleLinear) Value(v Decimal) int {
 	if a.rng.Min.Equal(a.rng.Max) {
-		return a.factory.Zero()
+		return 0
 	}
 
-	val := a.factory.NewFromInt64(int64(a.size - 1))
+	size := a.factory.NewFromInt64(int64(a.size - 1))
 
-	return val.Div(a.rng.Max.Sub(a.rng.Min))
-}
-
-// Value returns a scaled value from decimal.
-func (a *ScaleLinear) Value(v Decimal) int {
-	scale := a.scale()
-
-	ret := v.Sub(a.rng.Min).Mul(scale).IntPart()
+	// Multiplying before dividing keeps the precision of fixed-point
+	// decimals.
+	ret := v.Sub(a.rng.Min).Mul(size).Div(a.rng.Max.Sub(a.rng.Min)).IntPart()
 
 	return int(ret)
 }
+
+// Ticks implements ScaleTicker. The step between the ticks is 1, 2 or 5 times
+// a power of ten.
+func (a *ScaleLinear) Ticks(minGap int) []Decimal {
+	return niceTicks(a.factory, a.rng, a.size, minGap)
+}
@@ -38,3 +38,29 @@ func TestLiner(t *testing.T) {
 	assert.True(t, rev2.Equal(tplot.Float(6.6666666666666666)), rev2.String())
 	assert.True(t, rev3.Equal(tplot.Float(7.4999999999999999)), rev3.String())
 }
+
+func TestLiner_fixed(t *testing.T) {
+	factory := tplot.NewFixedFactory(8)
+
+	l := tplot.NewScaleLinear(factory)
+
+	rng := tplot.Range{
+		Min: factory.NewFromInt64(5),
+		Max: factory.NewFromInt64(10),
+	}
+
+	l.SetRange(rng)
+	l.SetSize(7)
+
+	assert.Equal(t, 0, l.NumDecimals())
+
+	assert.Equal(t, 0, l.Value(factory.NewFromInt64(5)))
+	assert.Equal(t, 3, l.Value(tplot.NewFixed(75, 1)))
+	assert.Equal(t, 6, l.Value(factory.NewFromInt64(10)))
+
+	assert.Equal(t, "5", l.Reverse(0).String())
+	assert.Equal(t, "5.8333333333333333", l.Reverse(1).String())
+	assert.Equal(t, "6.6666666666666667", l.Reverse(2).String())
+	assert.Equal(t, "7.5", l.Reverse(3).String())
+	assert.Equal(t, "10", l.Reverse(6).String())
+}
@@ -0,0 +1,180 @@
+package tplot
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// logEpsilon hides the floating point errors of the logarithms, e.g.
+// log10(1000) = 2.9999999999999996.
+const logEpsilon = 1e-9
+
+// ScaleLog represents a logarithmic scale. Values less than or equal to zero
+// cannot be represented on a logarithmic scale, so ScaleLog falls back to
+// linear scaling when the range is not strictly positive.
+type ScaleLog struct {
+	factory DecimalFactory
+
+	rng  Range
+	size int
+}
+
+var (
+	_ Scale       = &ScaleLog{}
+	_ ScaleTicker = &ScaleLog{}
+)
+
+// NewScaleLog constructs a new logarithmic scale.
+func NewScaleLog(factory DecimalFactory) *ScaleLog {
+	return &ScaleLog{
+		factory: factory,
+		rng:     NewRange(factory),
+	}
+}
+
+func (a *ScaleLog) Copy() Scale {
+	b := *a
+	return &b
+}
+
+// Size returns the scale size.
+func (a *ScaleLog) Size() int {
+	return a.size
+}
+
+// SetRange sets the scale range.
+func (a *ScaleLog) SetRange(rng Range) {
+	a.rng = rng
+}
+
+func (a *ScaleLog) Range() Range {
+	return a.rng
+}
+
+// SetSize sets the size.
+func (a *ScaleLog) SetSize(size int) {
+	a.size = size
+}
+
+// isLinear returns true when the range cannot be represented
+// logarithmically.
+func (a *ScaleLog) isLinear() bool {
+	return !a.rng.Min.GreaterThan(a.factory.Zero())
+}
+
+func (a *ScaleLog) linear() *ScaleLinear {
+	return &ScaleLinear{
+		factory: a.factory,
+		rng:     a.rng,
+		size:    a.size,
+	}
+}
+
+// logRange returns the natural logarithms of range min and max.
+func (a *ScaleLog) logRange() (float64, float64) {
+	return math.Log(a.rng.Min.Float64()), math.Log(a.rng.Max.Float64())
+}
+
+func (a *ScaleLog) Reverse(i int) Decimal {
+	if a.isLinear() {
+		return a.linear().Reverse(i)
+	}
+
+	s := a.size - 1
+	if s <= 0 {
+		return a.rng.Min
+	}
+
+	min, max := a.logRange()
+
+	val := math.Exp(min + (max-min)*float64(i)/float64(s))
+
+	// Keep 15 significant digits to hide the rounding errors of math.Exp.
+	val, _ = strconv.ParseFloat(strconv.FormatFloat(val, 'g', 15, 64), 64)
+
+	return NewFromFloat64(a.factory, val)
+}
+
+func (a *ScaleLog) NumDecimals() int {
+	if a.isLinear() {
+		return a.linear().NumDecimals()
+	}
+
+	s := a.size - 1
+	if s <= 0 {
+		return 0
+	}
+
+	min, max := a.logRange()
+
+	// The smallest step is the one at the bottom of the scale.
+	step := math.Exp(min+(max-min)/float64(s)) - math.Exp(min)
+	if step <= 0 {
+		return 0
+	}
+
+	numDecs := 0
+
+	if f := math.Log10(step); f < 0 {
+		numDecs = int(math.Abs(f))
+	}
+
+	return numDecs
+}
+
+// Value returns a scaled value from decimal.
+func (a *ScaleLog) Value(v Decimal) int {
+	if a.isLinear() {
+		return a.linear().Value(v)
+	}
+
+	min, max := a.logRange()
+	if max == min {
+		return 0
+	}
+
+	f := v.Float64()
+	if f <= 0 {
+		return 0
+	}
+
+	// Exact powers land on their row despite the rounding errors.
+	return int((math.Log(f)-min)/(max-min)*float64(a.size-1) + logEpsilon)
+}
+
+// Ticks implements ScaleTicker. The ticks are placed at powers of ten, or
+// every few powers of ten when they would be too close. When there are fewer
+// than two powers of ten in the range, the ticks are chosen as on a linear
+// scale.
+func (a *ScaleLog) Ticks(minGap int) []Decimal {
+	if a.isLinear() || a.size <= 1 {
+		return niceTicks(a.factory, a.rng, a.size, minGap)
+	}
+
+	min, max := a.logRange()
+	min, max = min/math.Ln10, max/math.Ln10
+
+	first := int(math.Ceil(min - logEpsilon))
+	last := int(math.Floor(max + logEpsilon))
+
+	if last-first < 1 {
+		return niceTicks(a.factory, a.rng, a.size, minGap)
+	}
+
+	perDecade := float64(a.size-1) / (max - min)
+	every := int(math.Ceil(float64(minGap) / perDecade))
+
+	var ret []Decimal
+
+	for exp := first; exp <= last; exp += every {
+		value, err := NewFromString(a.factory, fmt.Sprintf("1e%d", exp))
+		if err != nil || value.IsZero() {
+			continue
+		}
+
+		ret = append(ret, value)
+	}
+
+	return ret
+}
@@ -0,0 +1,81 @@
+package tplot_test
+
+import (
+	"testing"
+
+	"github.com/jeremija/tplot"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLog(t *testing.T) {
+	var factory tplot.FloatFactory
+
+	l := tplot.NewScaleLog(factory)
+
+	rng := tplot.Range{
+		Min: factory.NewFromInt64(1),
+		Max: factory.NewFromInt64(1000),
+	}
+
+	l.SetRange(rng)
+	l.SetSize(4)
+
+	assert.Equal(t, rng, l.Range())
+
+	assert.Equal(t, l.Size(), 4)
+
+	assert.Equal(t, 0, l.NumDecimals())
+
+	assert.Equal(t, 0, l.Value(factory.NewFromInt64(1)))
+	assert.Equal(t, 0, l.Value(factory.NewFromInt64(9)))
+	assert.Equal(t, 1, l.Value(factory.NewFromInt64(10)))
+	assert.Equal(t, 1, l.Value(factory.NewFromInt64(11)))
+	assert.Equal(t, 1, l.Value(factory.NewFromInt64(99)))
+	assert.Equal(t, 2, l.Value(factory.NewFromInt64(100)))
+	assert.Equal(t, 2, l.Value(factory.NewFromInt64(101)))
+	assert.Equal(t, 3, l.Value(factory.NewFromInt64(1000)))
+
+	for i := 0; i < 4; i++ {
+		assert.Equal(t, i, l.Value(l.Reverse(i)), "row %d", i)
+	}
+
+	// The logarithms of these decades are not exact.
+	l.SetRange(tplot.Range{
+		Min: tplot.Float(0.0001),
+		Max: tplot.Float(0.1),
+	})
+
+	assert.Equal(t, 0, l.Value(tplot.Float(0.0001)))
+	assert.Equal(t, 1, l.Value(tplot.Float(0.001)))
+	assert.Equal(t, 2, l.Value(tplot.Float(0.01)))
+	assert.Equal(t, 3, l.Value(tplot.Float(0.1)))
+
+	l.SetRange(rng)
+
+	rev0 := l.Reverse(0)
+	rev1 := l.Reverse(1)
+	rev2 := l.Reverse(2)
+	rev3 := l.Reverse(3)
+
+	assert.True(t, rev0.Equal(factory.NewFromInt64(1)), rev0.String())
+	assert.True(t, rev1.Equal(factory.NewFromInt64(10)), rev1.String())
+	assert.True(t, rev2.Equal(factory.NewFromInt64(100)), rev2.String())
+	assert.True(t, rev3.Equal(factory.NewFromInt64(1000)), rev3.String())
+}
+
+func TestLog_nonPositive(t *testing.T) {
+	var factory tplot.FloatFactory
+
+	l := tplot.NewScaleLog(factory)
+
+	l.SetRange(tplot.Range{
+		Min: factory.NewFromInt64(0),
+		Max: factory.NewFromInt64(6),
+	})
+	l.SetSize(7)
+
+	assert.Equal(t, 3, l.Value(factory.NewFromInt64(3)))
+
+	rev := l.Reverse(2)
+	assert.True(t, rev.Equal(factory.NewFromInt64(2)), rev.String())
+}
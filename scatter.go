@@ -0,0 +1,292 @@
+package tplot
+
+import (
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// DefaultScatterMarker is the marker of the points of a ScatterSeries without
+// a marker.
+const DefaultScatterMarker = '•'
+
+// DefaultDensityRunes contains the runes of the cells with multiple points,
+// from the lowest density.
+var DefaultDensityRunes = []rune{'░', '▒', '▓', '█'}
+
+// Point is a point of a scatter plot.
+type Point struct {
+	X Decimal
+	Y Decimal
+}
+
+// ScatterSeries is a named series of points drawn with its own marker and
+// style.
+type ScatterSeries struct {
+	// Name of the series, for example for a legend.
+	Name string
+	// Style used to draw the points.
+	Style tcell.Style
+	// Marker is the rune of a point. DefaultScatterMarker is used when the
+	// marker is not set.
+	Marker rune
+	// Points contains the points of the series.
+	Points []Point
+}
+
+// Scatter is a scatter plot of points with independent X and Y scales. The
+// values of the Y scale are labeled on a vertical axis on the left, and the
+// values of the X scale on a horizontal axis below the points. When multiple
+// points share a cell, the density of the cell is drawn instead of the
+// markers, in the style of the series with the most points in the cell.
+type Scatter struct {
+	*tview.Box
+
+	factory        DecimalFactory
+	series         []ScatterSeries
+	xScale         Scale
+	yScale         Scale
+	xAxis          *Axis
+	yAxis          *Axis
+	axesVisible    bool
+	densityVisible bool
+	densityRunes   []rune
+
+	// plot is the rect of the points during the last Draw.
+	plot rect
+}
+
+var _ PlotArea = &Scatter{}
+
+// NewScatter creates a new instance of Scatter.
+func NewScatter(factory DecimalFactory) *Scatter {
+	xAxis := NewAxis(factory)
+	xAxis.SetDirection(DirectionHorizontal)
+
+	return &Scatter{
+		Box:            tview.NewBox(),
+		factory:        factory,
+		xScale:         NewScaleLinear(factory),
+		yScale:         NewScaleLinear(factory),
+		xAxis:          xAxis,
+		yAxis:          NewAxis(factory),
+		axesVisible:    true,
+		densityVisible: true,
+		densityRunes:   DefaultDensityRunes,
+	}
+}
+
+// SetSeries sets the series.
+func (s *Scatter) SetSeries(series []ScatterSeries) {
+	s.series = series
+}
+
+// Series returns the series.
+func (s *Scatter) Series() []ScatterSeries {
+	return s.series
+}
+
+// SetXScale sets the scale of the X values, for example ScaleLog.
+func (s *Scatter) SetXScale(scale Scale) {
+	s.xScale = scale
+}
+
+// XScale returns the scale of the X values.
+func (s *Scatter) XScale() Scale {
+	return s.xScale
+}
+
+// SetScale sets the scale of the Y values.
+func (s *Scatter) SetScale(scale Scale) {
+	s.yScale = scale
+}
+
+// Scale returns the scale of the Y values.
+func (s *Scatter) Scale() Scale {
+	return s.yScale
+}
+
+// XAxis returns the horizontal axis of the X values, for example to set its
+// style or formatter.
+func (s *Scatter) XAxis() *Axis {
+	return s.xAxis
+}
+
+// YAxis returns the vertical axis of the Y values.
+func (s *Scatter) YAxis() *Axis {
+	return s.yAxis
+}
+
+// SetAxesVisible sets the visibility of both axes.
+func (s *Scatter) SetAxesVisible(visible bool) {
+	s.axesVisible = visible
+}
+
+// AxesVisible returns true when the axes are visible.
+func (s *Scatter) AxesVisible() bool {
+	return s.axesVisible
+}
+
+// SetDensityVisible sets whether the cells with multiple points are drawn
+// with the density runes. Otherwise the marker of the last series is drawn.
+func (s *Scatter) SetDensityVisible(visible bool) {
+	s.densityVisible = visible
+}
+
+// DensityVisible returns true when the density of the cells is drawn.
+func (s *Scatter) DensityVisible() bool {
+	return s.densityVisible
+}
+
+// SetDensityRunes sets the runes of the cells with multiple points, from the
+// lowest density.
+func (s *Scatter) SetDensityRunes(runes []rune) {
+	s.densityRunes = runes
+}
+
+// DensityRunes returns the runes of the cells with multiple points.
+func (s *Scatter) DensityRunes() []rune {
+	return s.densityRunes
+}
+
+// PlotRect implements PlotArea.
+func (s *Scatter) PlotRect() (int, int, int, int) {
+	return s.plot.x, s.plot.y, s.plot.w, s.plot.h
+}
+
+// calcRanges returns the ranges of the X and Y values.
+func (s *Scatter) calcRanges() (Range, Range) {
+	xRange := NewRange(s.factory)
+	yRange := NewRange(s.factory)
+
+	for _, series := range s.series {
+		for _, p := range series.Points {
+			if p.X == nil || p.Y == nil {
+				continue
+			}
+
+			xRange = xRange.Feed(p.X)
+			yRange = yRange.Feed(p.Y)
+		}
+	}
+
+	return xRange, yRange
+}
+
+// scatterCell counts the points of each series in a cell.
+type scatterCell struct {
+	counts []int
+	total  int
+	last   int
+}
+
+// Draw implements tview.Primitive.
+func (s *Scatter) Draw(screen tcell.Screen) {
+	s.DrawForSubclass(screen, s)
+
+	x, y, w, h := s.GetInnerRect()
+
+	s.plot = rect{x: x, y: y}
+
+	if w == 0 || h == 0 {
+		return
+	}
+
+	xRange, yRange := s.calcRanges()
+
+	s.xScale.SetRange(xRange)
+	s.yScale.SetRange(yRange)
+
+	axisW, axisH := 0, 0
+
+	if s.axesVisible && h > 1 {
+		axisH = 1
+
+		s.yScale.SetSize(h - axisH)
+		s.yAxis.SetScale(s.yScale)
+
+		// Hide the vertical axis when there's no room for the points.
+		if axisW = s.yAxis.CalcWidth(); axisW >= w {
+			axisW = 0
+		}
+	}
+
+	s.plot = rect{x: x + axisW, y: y, w: w - axisW, h: h - axisH}
+	plot := s.plot
+
+	s.xScale.SetSize(plot.w)
+	s.yScale.SetSize(plot.h)
+
+	cells := make(map[[2]int]*scatterCell)
+	maxTotal := 0
+
+	for i, series := range s.series {
+		for _, p := range series.Points {
+			if p.X == nil || p.Y == nil {
+				continue
+			}
+
+			col, row := s.xScale.Value(p.X), s.yScale.Value(p.Y)
+			if col < 0 || col >= plot.w || row < 0 || row >= plot.h {
+				continue
+			}
+
+			key := [2]int{col, row}
+
+			cell, ok := cells[key]
+			if !ok {
+				cell = &scatterCell{counts: make([]int, len(s.series))}
+				cells[key] = cell
+			}
+
+			cell.counts[i]++
+			cell.total++
+			cell.last = i
+
+			if cell.total > maxTotal {
+				maxTotal = cell.total
+			}
+		}
+	}
+
+	for key, cell := range cells {
+		xx, yy := plot.x+key[0], plot.y+plot.h-key[1]-1
+
+		series := s.series[cell.last]
+		ch := series.Marker
+
+		if ch == 0 {
+			ch = DefaultScatterMarker
+		}
+
+		if cell.total > 1 && s.densityVisible && len(s.densityRunes) > 0 {
+			n := len(s.densityRunes)
+			ch = s.densityRunes[((cell.total-1)*n-1)/(maxTotal-1)]
+
+			// The series with the most points in the cell.
+			most := cell.last
+
+			for i, count := range cell.counts {
+				if count > cell.counts[most] {
+					most = i
+				}
+			}
+
+			series = s.series[most]
+		}
+
+		screen.SetContent(xx, yy, ch, nil, series.Style)
+	}
+
+	if axisH == 0 {
+		return
+	}
+
+	if axisW > 0 {
+		s.yAxis.SetRect(x, y, axisW, plot.h)
+		s.yAxis.Draw(screen)
+	}
+
+	s.xAxis.SetScale(s.xScale)
+	s.xAxis.SetRect(plot.x, plot.y+plot.h, plot.w, axisH)
+	s.xAxis.Draw(screen)
+}
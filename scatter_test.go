@@ -0,0 +1,79 @@
+package tplot_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/jeremija/tplot"
+	"github.com/jeremija/tplot/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScatter(t *testing.T) {
+	var factory tplot.FloatFactory
+
+	p := func(x, y int64) tplot.Point {
+		return tplot.Point{
+			X: factory.NewFromInt64(x),
+			Y: factory.NewFromInt64(y),
+		}
+	}
+
+	get := tplot.ScatterSeries{
+		Name:   "GET",
+		Style:  tcell.StyleDefault.Foreground(tcell.ColorGreen),
+		Points: []tplot.Point{p(0, 0), p(10, 10), p(20, 20), p(20, 20)},
+	}
+
+	post := tplot.ScatterSeries{
+		Name:   "POST",
+		Style:  tcell.StyleDefault.Foreground(tcell.ColorBlue),
+		Marker: 'x',
+		Points: []tplot.Point{p(30, 0), p(30, 30), p(20, 20)},
+	}
+
+	s := tplot.NewScatter(factory)
+	s.SetSeries([]tplot.ScatterSeries{get, post})
+	s.SetRect(0, 0, 10, 5)
+
+	scr := test.NewScreen()
+	s.Draw(scr)
+
+	exp := `
+         x
+ 20    █
+     •
+  0•     x
+   0   20`
+
+	fmt.Println("== expected ==")
+	fmt.Println(exp)
+	fmt.Println("==  actual  ==")
+	fmt.Println(scr.Content())
+	fmt.Println("==============")
+
+	assert.Equal(t, exp, "\n"+scr.Content())
+
+	// The density is drawn in the style of the series with the most points.
+	fg, _, _ := scr.Style(7, 1).Decompose()
+	assert.Equal(t, tcell.ColorGreen, fg)
+
+	x, y, w, h := s.PlotRect()
+	assert.Equal(t, []int{3, 0, 7, 4}, []int{x, y, w, h})
+
+	s.SetDensityVisible(false)
+	s.SetAxesVisible(false)
+	s.SetRect(0, 0, 4, 4)
+
+	scr = test.NewScreen()
+	s.Draw(scr)
+
+	exp = `
+   x
+  x
+ •
+•  x`
+
+	assert.Equal(t, exp, "\n"+scr.Content())
+}
@@ -0,0 +1,40 @@
+package tplot
+
+import (
+	"github.com/gdamore/tcell/v2"
+)
+
+// Series is a named data series drawn in its own style by the charts that
+// can display multiple series, like MultiBars.
+type Series struct {
+	// Name of the series, for example for a legend.
+	Name string
+	// Style used to draw the series. Only the foreground color is used
+	// when the series are stacked.
+	Style tcell.Style
+	// Data contains the values of the series.
+	Data []Decimal
+}
+
+// seriesLen returns the length of the longest series.
+func seriesLen(series []Series) int {
+	l := 0
+
+	for _, s := range series {
+		if len(s.Data) > l {
+			l = len(s.Data)
+		}
+	}
+
+	return l
+}
+
+// seriesValue returns the value of the series at index i, or zero when the
+// series is shorter.
+func seriesValue(factory DecimalFactory, s Series, i int) Decimal {
+	if i < 0 || i >= len(s.Data) {
+		return factory.Zero()
+	}
+
+	return s.Data[i]
+}
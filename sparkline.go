@@ -0,0 +1,283 @@
+package tplot
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// DefaultSparklineRunes contains the block characters used to draw the eighths
+// of a cell.
+var DefaultSparklineRunes = []rune{'▁', '▂', '▃', '▄', '▅', '▆', '▇', '█'}
+
+// sparklineDecimals is the precision passed to the formatter of the last
+// value.
+const sparklineDecimals = 2
+
+// Sparkline draws the trend of the data in a single row, one rune per value,
+// for example in a tview.Table cell or a status bar. The values are drawn from
+// the left, and only the last values are kept when they do not fit. The
+// Sparkline can be drawn as a tview.Primitive, or converted to a string with
+// tview color tags, see Text.
+type Sparkline struct {
+	*tview.Box
+
+	factory          DecimalFactory
+	data             []Decimal
+	runes            []rune
+	style            tcell.Style
+	markersVisible   bool
+	minStyle         tcell.Style
+	maxStyle         tcell.Style
+	lastValueVisible bool
+	formatter        Formatter
+}
+
+// NewSparkline creates a new instance of Sparkline.
+func NewSparkline(factory DecimalFactory) *Sparkline {
+	return &Sparkline{
+		Box:       tview.NewBox(),
+		factory:   factory,
+		runes:     DefaultSparklineRunes,
+		style:     tcell.StyleDefault,
+		minStyle:  tcell.StyleDefault.Foreground(tcell.ColorRed),
+		maxStyle:  tcell.StyleDefault.Foreground(tcell.ColorGreen),
+		formatter: DefaultFormatter,
+	}
+}
+
+// SparklineText returns the data drawn as a sparkline of at most width runes
+// in the default style.
+func SparklineText(factory DecimalFactory, data []Decimal, width int) string {
+	s := NewSparkline(factory)
+	s.SetData(data)
+
+	return s.Text(width)
+}
+
+// SetData sets the data.
+func (s *Sparkline) SetData(data []Decimal) {
+	s.data = data
+}
+
+// Data returns the data.
+func (s *Sparkline) Data() []Decimal {
+	return s.data
+}
+
+// SetRunes sets the runes used to draw the values, from the lowest.
+func (s *Sparkline) SetRunes(runes []rune) {
+	s.runes = runes
+}
+
+// Runes returns the runes used to draw the values.
+func (s *Sparkline) Runes() []rune {
+	return s.runes
+}
+
+// SetStyle sets the style of the values.
+func (s *Sparkline) SetStyle(style tcell.Style) {
+	s.style = style
+}
+
+// Style returns the style of the values.
+func (s *Sparkline) Style() tcell.Style {
+	return s.style
+}
+
+// SetMarkersVisible sets the visibility of the markers of the minimum and the
+// maximum value.
+func (s *Sparkline) SetMarkersVisible(visible bool) {
+	s.markersVisible = visible
+}
+
+// MarkersVisible returns true when the minimum and the maximum are marked.
+func (s *Sparkline) MarkersVisible() bool {
+	return s.markersVisible
+}
+
+// SetMinStyle sets the style of the minimum value marker.
+func (s *Sparkline) SetMinStyle(style tcell.Style) {
+	s.minStyle = style
+}
+
+// MinStyle returns the style of the minimum value marker.
+func (s *Sparkline) MinStyle() tcell.Style {
+	return s.minStyle
+}
+
+// SetMaxStyle sets the style of the maximum value marker.
+func (s *Sparkline) SetMaxStyle(style tcell.Style) {
+	s.maxStyle = style
+}
+
+// MaxStyle returns the style of the maximum value marker.
+func (s *Sparkline) MaxStyle() tcell.Style {
+	return s.maxStyle
+}
+
+// SetLastValueVisible sets the visibility of the last value, which is drawn
+// after the values.
+func (s *Sparkline) SetLastValueVisible(visible bool) {
+	s.lastValueVisible = visible
+}
+
+// LastValueVisible returns true when the last value is drawn.
+func (s *Sparkline) LastValueVisible() bool {
+	return s.lastValueVisible
+}
+
+// SetFormatter sets the formatter of the last value. DefaultFormatter is used
+// when formatter is nil.
+func (s *Sparkline) SetFormatter(formatter Formatter) {
+	if formatter == nil {
+		formatter = DefaultFormatter
+	}
+
+	s.formatter = formatter
+}
+
+// Formatter returns the formatter of the last value.
+func (s *Sparkline) Formatter() Formatter {
+	return s.formatter
+}
+
+// sparklineCell is a rune of a sparkline with its style.
+type sparklineCell struct {
+	ch    rune
+	style tcell.Style
+}
+
+// cells returns at most width cells of the sparkline.
+func (s *Sparkline) cells(width int) []sparklineCell {
+	data := s.data
+
+	if len(data) == 0 || width <= 0 {
+		return nil
+	}
+
+	var text []rune
+
+	if s.lastValueVisible {
+		text = []rune(" " + s.formatter(data[len(data)-1], sparklineDecimals))
+
+		// Hide the last value when there's no room for the values.
+		if len(text) >= width {
+			text = nil
+		}
+	}
+
+	if l, max := len(data), width-len(text); l > max {
+		data = data[l-max:]
+	}
+
+	runes := s.runes
+	if len(runes) == 0 {
+		runes = []rune{'█'}
+	}
+
+	rng := NewRange(s.factory)
+	minIndex, maxIndex := 0, 0
+
+	for i, value := range data {
+		rng = rng.Feed(value)
+
+		if value.LessThan(data[minIndex]) {
+			minIndex = i
+		}
+
+		if value.GreaterThan(data[maxIndex]) {
+			maxIndex = i
+		}
+	}
+
+	scale := NewScaleLinear(s.factory)
+	scale.SetRange(rng)
+	scale.SetSize(len(runes))
+
+	ret := make([]sparklineCell, 0, len(data)+len(text))
+
+	for i, value := range data {
+		style := s.style
+
+		if s.markersVisible && rng.Max.GreaterThan(rng.Min) {
+			switch i {
+			case minIndex:
+				style = s.minStyle
+			case maxIndex:
+				style = s.maxStyle
+			}
+		}
+
+		ret = append(ret, sparklineCell{
+			ch:    runes[scale.Value(value)],
+			style: style,
+		})
+	}
+
+	for _, ch := range text {
+		ret = append(ret, sparklineCell{
+			ch:    ch,
+			style: s.style,
+		})
+	}
+
+	return ret
+}
+
+// Text returns the sparkline of at most width runes, with tview color tags
+// for the styles.
+func (s *Sparkline) Text(width int) string {
+	cells := s.cells(width)
+
+	if len(cells) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+
+	for i, cell := range cells {
+		if i == 0 || cell.style != cells[i-1].style {
+			b.WriteString(styleTag(cell.style))
+		}
+
+		b.WriteRune(cell.ch)
+	}
+
+	b.WriteString("[-:-:-]")
+
+	return b.String()
+}
+
+// Draw implements tview.Primitive. The sparkline is drawn in the first row.
+func (s *Sparkline) Draw(screen tcell.Screen) {
+	s.DrawForSubclass(screen, s)
+
+	x, y, w, h := s.GetInnerRect()
+
+	if h == 0 {
+		return
+	}
+
+	for i, cell := range s.cells(w) {
+		screen.SetContent(x+i, y, cell.ch, nil, cell.style)
+	}
+}
+
+// styleTag returns the tview color tag of the style.
+func styleTag(style tcell.Style) string {
+	fg, bg, _ := style.Decompose()
+
+	return fmt.Sprintf("[%s:%s]", colorTag(fg), colorTag(bg))
+}
+
+// colorTag returns the color in the format of the tview color tags.
+func colorTag(color tcell.Color) string {
+	if color == tcell.ColorDefault {
+		return "-"
+	}
+
+	return fmt.Sprintf("#%06x", color.Hex())
+}
@@ -0,0 +1,47 @@
+package tplot_test
+
+import (
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/jeremija/tplot"
+	"github.com/jeremija/tplot/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSparkline(t *testing.T) {
+	var factory tplot.FloatFactory
+
+	var data []tplot.Decimal
+
+	for _, v := range []int64{9, 1, 2, 3, 4, 5, 6, 7, 8} {
+		data = append(data, factory.NewFromInt64(v))
+	}
+
+	assert.Equal(t, "[-:-]█▁▁▂▃▄▅▆▇[-:-:-]", tplot.SparklineText(factory, data, 10))
+	assert.Equal(t, "[-:-]▁▂▃▄▅▆▇█[-:-:-]", tplot.SparklineText(factory, data, 8))
+	assert.Equal(t, "", tplot.SparklineText(factory, nil, 8))
+
+	s := tplot.NewSparkline(factory)
+	s.SetData(data[1:])
+	s.SetMarkersVisible(true)
+	s.SetLastValueVisible(true)
+	s.SetStyle(tcell.StyleDefault.Foreground(tcell.ColorBlue))
+
+	assert.Equal(t, "[#ff0000:-]▁[#0000ff:-]▃▅[#008000:-]█[#0000ff:-] 8.00[-:-:-]", s.Text(9))
+
+	// The last value is hidden when there's no room for the values.
+	assert.Equal(t, "[#ff0000:-]▁[#0000ff:-]▄[#008000:-]█[-:-:-]", s.Text(3))
+
+	scr := test.NewScreen()
+	s.SetRect(0, 0, 9, 1)
+	s.Draw(scr)
+
+	assert.Equal(t, "▁▃▅█ 8.00", scr.Content())
+
+	fg, _, _ := scr.Style(0, 0).Decompose()
+	assert.Equal(t, tcell.ColorRed, fg)
+
+	fg, _, _ = scr.Style(3, 0).Decompose()
+	assert.Equal(t, tcell.ColorGreen, fg)
+}
@@ -11,6 +11,7 @@ type Screen struct {
 	tcell.Screen
 
 	content [][]rune
+	styles  [][]tcell.Style
 }
 
 // NewScreen creates a new Screen mock.
@@ -21,24 +22,29 @@ func NewScreen() *Screen {
 // Clear implements tcell.Screen.
 func (s *Screen) Clear() {
 	s.content = nil
+	s.styles = nil
 }
 
 // SetContent implements tcell.Screen.
 func (s *Screen) SetContent(x int, y int, mainc rune, combc []rune, style tcell.Style) {
-	s.setRune(x, y, mainc)
+	s.setRune(x, y, mainc, style)
 
 	for i, c := range combc {
-		s.setRune(x+i+1, y, c)
+		s.setRune(x+i+1, y, c, style)
 	}
 }
 
-func (s *Screen) setRune(x, y int, mainc rune) {
+func (s *Screen) setRune(x, y int, mainc rune, style tcell.Style) {
 	yy := len(s.content)
 
 	if yy <= y {
 		v := make([][]rune, y+1)
 		copy(v, s.content)
 		s.content = v
+
+		st := make([][]tcell.Style, y+1)
+		copy(st, s.styles)
+		s.styles = st
 	}
 
 	row := s.content[y]
@@ -48,9 +54,41 @@ func (s *Screen) setRune(x, y int, mainc rune) {
 		v := make([]rune, x+1)
 		copy(v, row)
 		s.content[y] = v
+
+		st := make([]tcell.Style, x+1)
+		copy(st, s.styles[y])
+		s.styles[y] = st
 	}
 
 	s.content[y][x] = mainc
+	s.styles[y][x] = style
+}
+
+// Colors implements tcell.Screen. The mock supports true color.
+func (s *Screen) Colors() int {
+	return 1 << 24
+}
+
+// GetContent implements tcell.Screen.
+func (s *Screen) GetContent(x, y int) (mainc rune, combc []rune, style tcell.Style, width int) {
+	if y < 0 || y >= len(s.content) {
+		return ' ', nil, tcell.StyleDefault, 1
+	}
+
+	row := s.content[y]
+
+	if x < 0 || x >= len(row) {
+		return ' ', nil, tcell.StyleDefault, 1
+	}
+
+	return row[x], nil, s.styles[y][x], 1
+}
+
+// Style returns the style of the cell at x and y.
+func (s *Screen) Style(x, y int) tcell.Style {
+	_, _, style, _ := s.GetContent(x, y)
+
+	return style
 }
 
 // Content returns the current content as string. All trailing spaces will be
@@ -19,7 +19,7 @@ func NewTicks(factory DecimalFactory) *Ticks {
 func (b *Ticks) Draw(screen tcell.Screen) {
 	b.DrawForSubclass(screen, b)
 
-	data := b.DataSlice()
+	data, start := b.dataWindow()
 	scale := b.scale
 	spacing := b.spacing
 	runes := b.runes
@@ -30,6 +30,13 @@ func (b *Ticks) Draw(screen tcell.Screen) {
 		return
 	}
 
+	if b.renderMode == RenderBraille {
+		b.drawBraille(screen, data)
+		b.drawAnnotations(screen, start, data.Len())
+
+		return
+	}
+
 	// We are using special block characters to display quarters so we need
 	// to resize our scale after we've drawn the axis.
 	numFractions := len(b.runes)
@@ -46,9 +53,12 @@ func (b *Ticks) Draw(screen tcell.Screen) {
 	scale = scale.Copy()
 	scale.SetSize(h * numFractions)
 
-	for i, dec := range data {
+	l := data.Len()
+
+	for i := 0; i < l; i++ {
+		dec := data.At(i)
 		v := scale.Value(dec)
-		xx := x + i*spacing + (w - len(data)*spacing)
+		xx := x + i*spacing + (w - l*spacing)
 
 		fullSteps := v / numFractions
 		rem := v % numFractions
@@ -58,4 +68,24 @@ func (b *Ticks) Draw(screen tcell.Screen) {
 		yy := y + h - fullSteps - 1
 		screen.SetContent(xx, yy, ch, nil, style)
 	}
+
+	b.drawAnnotations(screen, start, l)
+}
+
+func (b *Ticks) drawBraille(screen tcell.Screen, data DataSource) {
+	x, y, _, _ := b.GetInnerRect()
+	canvas, scale := b.brailleCanvas(b.calcRange(data))
+	dotW, dotH := canvas.DotSize()
+
+	l := data.Len()
+
+	for i := 0; i < l; i++ {
+		dec := data.At(i)
+		v := scale.Value(dec)
+		xx := i*b.spacing + (dotW - l*b.spacing)
+
+		canvas.Point(xx, dotH-v-1, b.style)
+	}
+
+	canvas.Draw(screen, x, y)
 }
@@ -10,28 +10,35 @@ import (
 )
 
 func TestTicks(t *testing.T) {
-	var factory tplot.FloatFactory
+	testTicks(t, tplot.FloatFactory{})
+}
+
+func TestTicks_fixed(t *testing.T) {
+	testTicks(t, tplot.NewFixedFactory(8))
+}
 
+func testTicks(t *testing.T, factory tplot.DecimalFactory) {
 	p := tplot.NewTicks(factory)
 	scr := test.NewScreen()
 
-	vals := []tplot.Float{
+	// Values in tenths.
+	vals := []int64{
 		0,
-		1.5,
-		2.2,
-		3.4,
-		4.6,
-		5.8,
-		6,
-		9.2,
-		8.5,
-		10,
+		15,
+		22,
+		34,
+		46,
+		58,
+		60,
+		92,
+		85,
+		100,
 	}
 
 	data := make([]tplot.Decimal, len(vals))
 
 	for i, val := range vals {
-		data[i] = val
+		data[i] = factory.NewFromInt64(val).Div(factory.NewFromInt64(10))
 	}
 
 	p.SetRect(0, 0, 12, 10)